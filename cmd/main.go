@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/bartosian/suimon/internal/core/controllers"
 	"github.com/bartosian/suimon/internal/core/controllers/monitor"
@@ -27,6 +30,8 @@ func main() {
 	// Instantiate controllers
 	rootController := controllers.NewRootController(cliGateway)
 	versionController := controllers.NewVersionController(cliGateway)
+	cacheController := controllers.NewCacheController(cliGateway)
+	historyController := controllers.NewHistoryController(cliGateway)
 	monitorController := monitor.NewController(config, cliGateway)
 
 	// Instantiate Handlers - Root
@@ -35,12 +40,22 @@ func main() {
 	// Instantiate Handlers - second level
 	versionCmdHandler := cmdhandlers.NewVersionHandler(versionController)
 	monitorCmdHandler := cmdhandlers.NewMonitorHandler(monitorController)
+	cacheCmdHandler := cmdhandlers.NewCacheHandler(cacheController)
+	historyCmdHandler := cmdhandlers.NewHistoryHandler(historyController)
+	protocolCmdHandler := cmdhandlers.NewProtocolHandler(monitorController)
+	releasesCmdHandler := cmdhandlers.NewReleasesHandler(monitorController)
+	reportsCmdHandler := cmdhandlers.NewReportsHandler(monitorController)
+	forecastCmdHandler := cmdhandlers.NewForecastHandler(monitorController)
 
 	// Add subcommands to the root command handler
-	rootCmdHandler.AddSubCommands(versionCmdHandler, monitorCmdHandler)
+	rootCmdHandler.AddSubCommands(versionCmdHandler, monitorCmdHandler, cacheCmdHandler, historyCmdHandler, protocolCmdHandler, releasesCmdHandler, reportsCmdHandler, forecastCmdHandler)
+
+	// Cancel the context on interrupt to stop all in-flight requests and shut down gracefully
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	// Start the root command handler
-	rootCmdHandler.Start()
+	rootCmdHandler.Start(ctx)
 }
 
 func handlePanic(cliGateway *cligw.Gateway) {
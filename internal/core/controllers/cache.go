@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"log/slog"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/diskcache"
+)
+
+type CacheController struct {
+	cliGateway *cligw.Gateway
+}
+
+func NewCacheController(
+	cliGateway *cligw.Gateway,
+) ports.CacheController {
+	return &CacheController{
+		cliGateway: cliGateway,
+	}
+}
+
+// Clear removes the suimon caches stored under ~/.suimon/cache, such as the geolocation data.
+func (c *CacheController) Clear() error {
+	cacheDir, err := config.CacheDir()
+	if err != nil {
+		return err
+	}
+
+	if err := diskcache.Clear(cacheDir); err != nil {
+		return err
+	}
+
+	slog.Info("Cache cleared", "path", cacheDir)
+
+	return nil
+}
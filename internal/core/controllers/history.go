@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/gateways/historygw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/asciichart"
+)
+
+const (
+	historyChartHeight = 10
+	historyTimeLayout  = "2006-01-02 15:04:05"
+	historyValueScale  = 100
+)
+
+type HistoryController struct {
+	cliGateway *cligw.Gateway
+}
+
+func NewHistoryController(
+	cliGateway *cligw.Gateway,
+) ports.HistoryController {
+	return &HistoryController{
+		cliGateway: cliGateway,
+	}
+}
+
+// Show charts the metric recorded in ~/.suimon/history.db for the hosts matching the query,
+// followed by the minimum, maximum and last values and the changes of the host status.
+func (c *HistoryController) Show(query ports.HistoryQuery) error {
+	historyFile, err := config.HistoryFile()
+	if err != nil {
+		return err
+	}
+
+	historyGateway := historygw.NewGateway(historyFile, 0, 0)
+
+	keys, err := historyGateway.Keys()
+	if err != nil {
+		return err
+	}
+
+	keys = filterHistoryKeys(keys, query)
+	if len(keys) == 0 {
+		return errors.New("no history recorded for the hosts requested")
+	}
+
+	since := time.Now().Add(-query.Since)
+
+	for _, key := range keys {
+		samples, err := historyGateway.Samples(key, since)
+		if err != nil {
+			return err
+		}
+
+		c.showHostHistory(key, samples, query)
+	}
+
+	return nil
+}
+
+// showHostHistory prints the chart of the metric, its summary and the status changes of a single host.
+func (c *HistoryController) showHostHistory(key ports.HistoryKey, samples []ports.HistorySample, query ports.HistoryQuery) {
+	c.cliGateway.Info(fmt.Sprintf("%s %s", key.Network, key.Table), key.Address)
+
+	values := make([]float64, 0, len(samples))
+	recordedMetrics := make(map[enums.MetricType]struct{})
+
+	for _, sample := range samples {
+		for metricType := range sample.Values {
+			recordedMetrics[metricType] = struct{}{}
+		}
+
+		if value, ok := sample.Values[query.Metric]; ok {
+			values = append(values, value)
+		}
+	}
+
+	if len(values) == 0 {
+		available := make([]string, 0, len(recordedMetrics))
+		for metricType := range recordedMetrics {
+			available = append(available, strings.ToLower(metricType.ToString()))
+		}
+
+		sort.Strings(available)
+
+		c.cliGateway.Warn(fmt.Sprintf("no samples of %s recorded since %s, recorded metrics: %s",
+			strings.ToLower(query.Metric.ToString()), time.Now().Add(-query.Since).Format(historyTimeLayout), strings.Join(available, ", ")))
+
+		return
+	}
+
+	fmt.Println(asciichart.Plot(values, historyChartHeight, query.Width))
+
+	minValue, maxValue := values[0], values[0]
+	for _, value := range values {
+		minValue = min(minValue, value)
+		maxValue = max(maxValue, value)
+	}
+
+	c.cliGateway.Info("SAMPLES", strconv.Itoa(len(values)))
+	c.cliGateway.Info("MIN / MAX / LAST", fmt.Sprintf("%s / %s / %s", formatHistoryValue(minValue), formatHistoryValue(maxValue), formatHistoryValue(values[len(values)-1])))
+
+	for idx := 1; idx < len(samples); idx++ {
+		previous, current := samples[idx-1], samples[idx]
+		if previous.Status == current.Status {
+			continue
+		}
+
+		c.cliGateway.Info("STATUS CHANGE", fmt.Sprintf("%s %s -> %s",
+			current.Time.Local().Format(historyTimeLayout), previous.Status, current.Status))
+	}
+}
+
+// filterHistoryKeys returns the keys matching the network and host of the query, sorted by network, table and address.
+func filterHistoryKeys(keys []ports.HistoryKey, query ports.HistoryQuery) []ports.HistoryKey {
+	filtered := make([]ports.HistoryKey, 0, len(keys))
+
+	for _, key := range keys {
+		if query.Network != "" && !strings.EqualFold(key.Network, query.Network) {
+			continue
+		}
+
+		if query.Host != "" && !strings.Contains(key.Address, query.Host) {
+			continue
+		}
+
+		filtered = append(filtered, key)
+	}
+
+	sort.Slice(filtered, func(left, right int) bool {
+		if filtered[left].Network != filtered[right].Network {
+			return filtered[left].Network < filtered[right].Network
+		}
+
+		if filtered[left].Table != filtered[right].Table {
+			return filtered[left].Table < filtered[right].Table
+		}
+
+		return filtered[left].Address < filtered[right].Address
+	})
+
+	return filtered
+}
+
+// formatHistoryValue formats the value rounded to two decimals, without the trailing zeros.
+func formatHistoryValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*historyValueScale)/historyValueScale, 'f', -1, 64)
+}
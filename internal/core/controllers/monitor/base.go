@@ -13,7 +13,12 @@ import (
 )
 
 type Gateways struct {
-	cli *cligw.Gateway
+	cli      *cligw.Gateway
+	geo      ports.GeoGateway
+	history  ports.HistoryGateway
+	release  ports.ReleaseGateway
+	report   ports.ReportGateway
+	snapshot ports.SnapshotGateway
 }
 
 type Hosts struct {
@@ -38,7 +43,9 @@ type Controller struct {
 	selectedDashboard enums.TableType
 	hosts             Hosts
 	releases          []metrics.Release
+	releasesLock      sync.Mutex
 	selectedTables    []enums.TableType
+	options           ports.MonitorOptions
 	lock              sync.RWMutex
 }
 
@@ -83,8 +90,13 @@ func (c *Controller) getHostsByTableType(table enums.TableType) (hosts []host.Ho
 		enums.TableTypeGasPriceAndSubsidy,
 		enums.TableTypeValidatorParams,
 		enums.TableTypeValidatorsAtRisk,
+		enums.TableTypeAtRiskProjection,
+		enums.TableTypeNextEpochChanges,
 		enums.TableTypeValidatorReports,
-		enums.TableTypeProtocol:
+		enums.TableTypeProtocol,
+		enums.TableTypeDecentralization,
+		enums.TableTypeStakeDistribution,
+		enums.TableTypeValidatorSet:
 		if len(c.hosts.rpc) > 0 {
 			return c.hosts.rpc[:1], nil
 		}
@@ -116,9 +128,14 @@ func (c *Controller) setHostsByTableType(table enums.TableType, hosts []host.Hos
 		enums.TableTypeGasPriceAndSubsidy,
 		enums.TableTypeValidatorParams,
 		enums.TableTypeValidatorsAtRisk,
+		enums.TableTypeAtRiskProjection,
+		enums.TableTypeNextEpochChanges,
 		enums.TableTypeValidatorReports,
 		enums.TableTypeProtocol,
-		enums.TableTypeReleases:
+		enums.TableTypeReleases,
+		enums.TableTypeDecentralization,
+		enums.TableTypeStakeDistribution,
+		enums.TableTypeValidatorSet:
 		return nil
 	default:
 		return fmt.Errorf("unknown table type: %v", table)
@@ -1,17 +1,45 @@
 package monitor
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
 
+	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
 	"github.com/bartosian/suimon/internal/core/gateways/geogw"
+	"github.com/bartosian/suimon/internal/core/gateways/graphqlgw"
+	"github.com/bartosian/suimon/internal/core/gateways/grpcgw"
+	"github.com/bartosian/suimon/internal/core/gateways/mmdbgw"
 	"github.com/bartosian/suimon/internal/core/gateways/prometheusgw"
+	"github.com/bartosian/suimon/internal/core/gateways/promqlgw"
 	"github.com/bartosian/suimon/internal/core/gateways/rpcgw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/diskcache"
 )
 
+const (
+	defaultInstanceLabel = "instance"
+	defaultGeoCacheTTL   = 7 * 24 * time.Hour
+	geoCacheDir          = "geo"
+)
+
+// customMetricsTables maps the table types supporting the custom metrics to the names used in the config file.
+var customMetricsTables = map[enums.TableType]string{
+	enums.TableTypeNode:      "node",
+	enums.TableTypeValidator: "validator",
+}
+
 type responseWithError struct {
 	response *host.Host
 	err      error
@@ -19,11 +47,27 @@ type responseWithError struct {
 
 // createHosts creates hosts based on the provided table type and addresses.
 // It initializes the hosts, processes the addresses, and sets up the necessary gateways for each host.
+// The provided context is passed to every call made while initializing the hosts.
 // It returns the created hosts and any error encountered during the process.
-func (c *Controller) createHosts(table enums.TableType, addresses []host.AddressInfo) ([]host.Host, error) {
+func (c *Controller) createHosts(ctx context.Context, table enums.TableType, addresses []host.AddressInfo) ([]host.Host, error) {
 	hosts := make([]host.Host, 0, len(addresses))
 	processedAddresses := make(map[string]struct{})
 
+	rateConfig, err := c.getRateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	customMetrics, err := c.getCustomMetrics(table)
+	if err != nil {
+		return nil, err
+	}
+
+	geoGateway, err := c.getGeoGateway()
+	if err != nil {
+		return nil, err
+	}
+
 	respChan := make(chan responseWithError, len(addresses))
 
 	var wg sync.WaitGroup
@@ -60,22 +104,43 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 				return
 			}
 
-			rpcGateway := rpcgw.NewGateway(c.gateways.cli, rpcURL)
-			prometheusGateway := prometheusgw.NewGateway(c.gateways.cli, metricsURL)
-			geoGateway := geogw.NewGateway(c.gateways.cli, c.selectedConfig.IPLookup.AccessToken)
+			rpcGateway := c.newRPCGateway(addressInfo.Protocol, rpcURL)
+			prometheusGateway, err := c.newPrometheusGateway(addressInfo, metricsURL)
+			if err != nil {
+				sendErrorResponse(result, err)
+				return
+			}
 
 			createdHost := host.NewHost(table, addressInfo, rpcGateway, geoGateway, prometheusGateway, c.gateways.cli)
+			createdHost.Metrics.RateConfig = rateConfig
+			createdHost.Metrics.Rates = metrics.NewRates(rateConfig)
+			createdHost.Metrics.CustomMetrics = append(metrics.CustomMetrics(nil), customMetrics...)
 			result.response = createdHost
 
-			if c.selectedConfig.IPLookup.AccessToken != "" {
-				if createErr := createdHost.SetIPInfo(); createErr != nil {
+			if resolveErr := createdHost.ResolveIPs(ctx); resolveErr != nil {
+				if table == enums.TableTypeRPC || !createdHost.HasDNSError() {
+					sendErrorResponse(result, resolveErr)
+					return
+				}
+
+				// The host is kept to show the DNS error in the tables. Its metrics are still requested by the hostname,
+				// which may resolve later, and the host is shown as unhealthy as long as they are not updated.
+				_ = createdHost.GetMetrics(ctx)
+
+				respChan <- result
+
+				return
+			}
+
+			if geoGateway != nil {
+				if createErr := createdHost.SetIPInfo(ctx); createErr != nil {
 					sendErrorResponse(result, createErr)
 					return
 				}
 			}
 
-			if getMetricsErr := createdHost.GetMetrics(); getMetricsErr != nil {
-				sendErrorResponse(result, err)
+			if getMetricsErr := createdHost.GetMetrics(ctx); getMetricsErr != nil {
+				sendErrorResponse(result, getMetricsErr)
 				return
 			}
 
@@ -98,9 +163,233 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 		}
 	}
 
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+
 	if len(hosts) == 0 {
 		return nil, mErr.ErrorOrNil()
 	}
 
 	return hosts, nil
 }
+
+// newRPCGateway creates the RPC gateway for the protocol configured for the host.
+// Hosts without an explicit protocol are queried over JSON-RPC.
+func (c *Controller) newRPCGateway(protocol enums.RPCProtocol, url string) ports.RPCGateway {
+	switch protocol {
+	case enums.RPCProtocolGraphQL:
+		return graphqlgw.NewGateway(c.gateways.cli, url)
+	case enums.RPCProtocolGRPC:
+		return grpcgw.NewGateway(c.gateways.cli, url)
+	default:
+		return rpcgw.NewGateway(c.gateways.cli, url)
+	}
+}
+
+// newPrometheusGateway creates the Prometheus gateway for the host.
+// If a Prometheus server is configured, the host metrics are queried from the server by the instance label,
+// otherwise they are scraped from the metrics endpoint of the host.
+func (c *Controller) newPrometheusGateway(addressInfo host.AddressInfo, metricsURL string) (ports.PrometheusGateway, error) {
+	prometheusConfig := c.selectedConfig.Prometheus
+	if prometheusConfig.URL == "" {
+		return prometheusgw.NewGateway(c.gateways.cli, metricsURL), nil
+	}
+
+	if prometheusConfig.RateRange != "" {
+		if _, err := model.ParseDuration(prometheusConfig.RateRange); err != nil {
+			return nil, fmt.Errorf("invalid prometheus rate-range: %s", prometheusConfig.RateRange)
+		}
+	}
+
+	instance, err := addressInfo.GetInstance()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceLabel := prometheusConfig.InstanceLabel
+	if instanceLabel == "" {
+		instanceLabel = defaultInstanceLabel
+	}
+
+	selector := make(prometheus.Labels, len(prometheusConfig.Labels)+1)
+	for key, value := range prometheusConfig.Labels {
+		selector[key] = value
+	}
+
+	selector[instanceLabel] = instance
+
+	return promqlgw.NewGateway(c.gateways.cli, prometheusConfig.URL, selector, prometheusConfig.RateRange), nil
+}
+
+// getGeoGateway returns the geolocation gateway selected in the ip-lookup config section, creating it on the first use,
+// so the databases are opened once and the lookups are cached on disk for all the hosts and runs.
+// The mmdb gateway falls back to ipinfo for the addresses not found in the databases if the access token is set.
+// It returns nil if no lookups are configured.
+func (c *Controller) getGeoGateway() (ports.GeoGateway, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gateways.geo != nil {
+		return c.gateways.geo, nil
+	}
+
+	ipLookup := c.selectedConfig.IPLookup
+
+	provider, err := enums.ParseGeoProvider(ipLookup.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ip-lookup in config file: %w", err)
+	}
+
+	var ipInfoGateway ports.GeoGateway
+	if ipLookup.AccessToken != "" {
+		ipInfoGateway = geogw.NewGateway(c.gateways.cli, ipLookup.AccessToken)
+	}
+
+	var geoGateway ports.GeoGateway
+
+	switch provider {
+	case enums.GeoProviderMMDB:
+		if geoGateway, err = mmdbgw.NewGateway(c.gateways.cli, ipLookup.Databases, ipInfoGateway); err != nil {
+			return nil, fmt.Errorf("invalid ip-lookup in config file: %w", err)
+		}
+	case enums.GeoProviderIPInfo:
+		geoGateway = ipInfoGateway
+	}
+
+	if geoGateway == nil {
+		return nil, nil
+	}
+
+	geoCache, err := c.getGeoCache()
+	if err != nil {
+		return nil, err
+	}
+
+	if geoCache != nil {
+		geoGateway = geogw.NewCachedGateway(c.gateways.cli, geoGateway, geoCache)
+	}
+
+	c.gateways.geo = geoGateway
+
+	return geoGateway, nil
+}
+
+// getGeoCache creates the on-disk cache of the geolocation data under ~/.suimon/cache with the TTL from the ip-lookup config section.
+// It returns nil if the cache is disabled with a zero TTL or the cache directory cannot be created, in which case a warning is shown.
+func (c *Controller) getGeoCache() (*diskcache.Cache, error) {
+	ttl := defaultGeoCacheTTL
+
+	if ttlValue := c.selectedConfig.IPLookup.CacheTTL; ttlValue != "" {
+		parsedTTL, err := model.ParseDuration(ttlValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip-lookup cache-ttl in config file: %s", ttlValue)
+		}
+
+		ttl = time.Duration(parsedTTL)
+	}
+
+	if ttl == 0 {
+		return nil, nil
+	}
+
+	cacheDir, err := config.CacheDir()
+	if err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("geolocation cache disabled: %v", err))
+
+		return nil, nil
+	}
+
+	geoCache, err := diskcache.New(filepath.Join(cacheDir, geoCacheDir), ttl)
+	if err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("geolocation cache disabled: %v", err))
+
+		return nil, nil
+	}
+
+	return geoCache, nil
+}
+
+// getRateConfig parses the rates section of the selected config.
+// The sliding window of metrics.DefaultRateWindow is used if the section is not provided.
+func (c *Controller) getRateConfig() (metrics.RateConfig, error) {
+	ratesConfig := c.selectedConfig.Rates
+
+	mode, err := enums.ParseRateMode(ratesConfig.Mode)
+	if err != nil {
+		return metrics.RateConfig{}, err
+	}
+
+	rateConfig := metrics.RateConfig{
+		Mode:   mode,
+		Window: metrics.DefaultRateWindow,
+	}
+
+	if ratesConfig.Window != "" {
+		window, parseErr := time.ParseDuration(ratesConfig.Window)
+		if parseErr != nil || window <= 0 {
+			return metrics.RateConfig{}, fmt.Errorf("invalid rates window: %s", ratesConfig.Window)
+		}
+
+		rateConfig.Window = window
+	}
+
+	return rateConfig, nil
+}
+
+// getCustomMetrics parses the custom metrics of the selected config requested for the table type.
+// Custom metrics are requested for the node and validator tables only.
+func (c *Controller) getCustomMetrics(table enums.TableType) (metrics.CustomMetrics, error) {
+	tableName, ok := customMetricsTables[table]
+	if !ok {
+		return nil, nil
+	}
+
+	var customMetrics metrics.CustomMetrics
+
+	for _, customConfig := range c.selectedConfig.CustomMetrics {
+		if customConfig.Name == "" {
+			return nil, errors.New("invalid custom metric in config file: name is required")
+		}
+
+		if len(customConfig.Tables) > 0 && !slices.Contains(customConfig.Tables, tableName) {
+			continue
+		}
+
+		metricType, err := enums.ParsePrometheusMetricType(customConfig.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom metric %s in config file: %w", customConfig.Name, err)
+		}
+
+		rateUnit, err := enums.ParseRateUnit(customConfig.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom metric %s in config file: %w", customConfig.Name, err)
+		}
+
+		if rateUnit != enums.RateUnitNone && metricType.IsDistribution() {
+			return nil, fmt.Errorf("invalid custom metric %s in config file: rate is not supported for histograms and summaries", customConfig.Name)
+		}
+
+		aliases := make([]enums.PrometheusMetricName, 0, len(customConfig.Aliases))
+		for _, alias := range customConfig.Aliases {
+			aliases = append(aliases, enums.PrometheusMetricName(alias))
+		}
+
+		customMetrics = append(customMetrics, metrics.NewCustomMetric(
+			enums.PrometheusMetricName(customConfig.Name),
+			aliases,
+			customConfig.Labels,
+			metricType,
+			customConfig.DisplayName,
+			customConfig.Unit,
+			rateUnit,
+			customConfig.Dashboard,
+		))
+	}
+
+	if err := host.ValidateCustomMetrics(table, customMetrics); err != nil {
+		return nil, fmt.Errorf("invalid custom metrics in config file: %w", err)
+	}
+
+	return customMetrics, nil
+}
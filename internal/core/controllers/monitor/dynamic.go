@@ -1,22 +1,33 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 // Dynamic is a method of the Controller struct, responsible for initializing and rendering dashboards
-// based on the configuration data.
-func (c *Controller) Dynamic() error {
+// based on the configuration data. While the dashboard is shown, the epoch changes are watched in the background
+// and an epoch report is saved to ~/.suimon/reports on each of them.
+func (c *Controller) Dynamic(ctx context.Context) error {
 	// Parse the configuration data.
-	if err := c.ParseConfigData(enums.MonitorTypeDynamic); err != nil {
+	if err := c.ParseConfigData(ctx, enums.MonitorTypeDynamic); err != nil {
 		return err
 	}
 
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+
+	go func() {
+		_ = c.watchEpochs(watchCtx, epochWatchInterval, false)
+	}()
+
 	// Initialize dashboard based on the configuration data.
-	if err := c.InitDashboard(); err != nil {
+	if err := c.InitDashboard(ctx); err != nil {
 		return err
 	}
 
@@ -25,9 +36,11 @@ func (c *Controller) Dynamic() error {
 }
 
 // InitDashboard initializes the enabled dashboard based on the display configuration.
-// It retrieves the corresponding hosts for the dashboard and initializes the dashboard builder.
+// It retrieves the corresponding hosts for the dashboard and initializes the dashboard builder,
+// passing the metrics history of the host to prefill the sparklines with.
+// The dashboard is stopped when the provided context is done.
 // If an error occurs during table initialization, it returns an error.
-func (c *Controller) InitDashboard() error {
+func (c *Controller) InitDashboard(ctx context.Context) error {
 	selectedDashboard := c.selectedDashboard
 
 	host, err := c.selectHostForDashboard()
@@ -35,7 +48,12 @@ func (c *Controller) InitDashboard() error {
 		return err
 	}
 
-	builder, err := dashboardbuilder.NewBuilder(selectedDashboard, host, c.gateways.cli)
+	historyGateway, historyKey, err := c.getDashboardHistory(selectedDashboard, host)
+	if err != nil {
+		return err
+	}
+
+	builder, err := dashboardbuilder.NewBuilder(ctx, selectedDashboard, host, c.gateways.cli, historyGateway, historyKey)
 	if err != nil {
 		return fmt.Errorf("error creating dashboard %s: %w", selectedDashboard, err)
 	}
@@ -44,3 +62,18 @@ func (c *Controller) InitDashboard() error {
 
 	return builder.Init()
 }
+
+// getDashboardHistory returns the metrics history gateway and the key of the host the dashboard is rendered for.
+// It returns a nil gateway if the hosts of the dashboard are not recorded or the history is disabled.
+func (c *Controller) getDashboardHistory(dashboard enums.TableType, host *domainhost.Host) (ports.HistoryGateway, ports.HistoryKey, error) {
+	if host == nil || !historyTables[dashboard] {
+		return nil, ports.HistoryKey{}, nil
+	}
+
+	historyGateway, err := c.getHistoryGateway()
+	if err != nil {
+		return nil, ports.HistoryKey{}, err
+	}
+
+	return historyGateway, host.GetHistoryKey(c.network), nil
+}
@@ -0,0 +1,133 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/gateways/reportgw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// epochWatchInterval is how often the system state is polled for the epoch changes while the dashboard is shown.
+const epochWatchInterval = 30 * time.Second
+
+// WatchEpochs prompts the user to select a configuration and polls the system state of the reference RPC host
+// at the interval until the context is done, saving a report to ~/.suimon/reports/<network> on every epoch change.
+func (c *Controller) WatchEpochs(ctx context.Context, interval time.Duration) error {
+	if err := c.chooseConfiguration(); err != nil {
+		return err
+	}
+
+	if err := c.ParseConfigRPC(ctx); err != nil {
+		return err
+	}
+
+	c.lock.RLock()
+	epoch := c.hosts.rpc[0].Metrics.SystemState.Epoch
+	c.lock.RUnlock()
+
+	c.gateways.cli.Info("WATCHING EPOCHS", fmt.Sprintf("network %s, current epoch %s, polling every %s", c.network, epoch, interval))
+
+	err := c.watchEpochs(ctx, interval, true)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// watchEpochs polls the system state of the reference RPC host at the interval and saves the report of the epoch
+// ended on every epoch change, until the context is done. The failed requests are retried on the next tick.
+// If verbose is set, the reports saved and the failures are printed, otherwise the watcher runs silently
+// not to break the dashboard rendered.
+func (c *Controller) watchEpochs(ctx context.Context, interval time.Duration, verbose bool) error {
+	reportGateway, err := c.getReportGateway()
+	if err != nil {
+		return err
+	}
+
+	c.lock.RLock()
+	if len(c.hosts.rpc) == 0 {
+		c.lock.RUnlock()
+
+		return errors.New("no rpc hosts available to watch the epochs")
+	}
+
+	rpcHost := c.hosts.rpc[0]
+	c.lock.RUnlock()
+
+	previous := rpcHost.Metrics.SystemState
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if err := rpcHost.GetDataByMetric(ctx, enums.RPCMethodGetSuiSystemState); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			if verbose {
+				c.gateways.cli.Warn(fmt.Sprintf("failed to get system state, retrying in %s: %s", interval, err))
+			}
+
+			continue
+		}
+
+		current := rpcHost.Metrics.SystemState
+
+		if current.Epoch != previous.Epoch {
+			paths, err := c.saveEpochReport(reportGateway, &previous, &current)
+
+			switch {
+			case err != nil && verbose:
+				c.gateways.cli.Warn(fmt.Sprintf("failed to save epoch %s report: %s", previous.Epoch, err))
+			case err == nil && verbose:
+				c.gateways.cli.Info(fmt.Sprintf("EPOCH %s REPORT", previous.Epoch), strings.Join(paths, ", "))
+			}
+		}
+
+		previous = current
+	}
+}
+
+// saveEpochReport creates the report of the epoch ended between the system states and saves it.
+func (c *Controller) saveEpochReport(reportGateway ports.ReportGateway, previous, current *domainmetrics.SuiSystemState) ([]string, error) {
+	report, err := domainmetrics.NewEpochReport(c.network, previous, current, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return reportGateway.SaveEpochReport(report)
+}
+
+// getReportGateway returns the gateway saving the reports to ~/.suimon/reports, creating it on the first use.
+func (c *Controller) getReportGateway() (ports.ReportGateway, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gateways.report != nil {
+		return c.gateways.report, nil
+	}
+
+	reportsDir, err := config.ReportsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	c.gateways.report = reportgw.NewGateway(reportsDir)
+
+	return c.gateways.report, nil
+}
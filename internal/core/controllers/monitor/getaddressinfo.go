@@ -45,7 +45,7 @@ func (c *Controller) getAddressInfoByTableType(table enums.TableType) ([]host.Ad
 }
 
 // getNodeAddresses extracts the JSON-RPC and metrics addresses from the selected config's full nodes and
-// returns an array of host.AddressInfo structs that include the endpoints and port numbers.
+// returns an array of host.AddressInfo structs that include the endpoints, port numbers and RPC protocol.
 // The parser argument is a function used to parse the address strings.
 // Returns an error if there is an invalid address format or if there is no JSON-RPC or metrics address provided for a full node.
 func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.AddressInfo, err error) {
@@ -61,6 +61,11 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 			return nil, errors.New("invalid format for full-node in dashboards file: at least one of json-rpc-address or metrics-address is required")
 		}
 
+		protocol, protocolErr := enums.ParseRPCProtocol(node.Protocol)
+		if protocolErr != nil {
+			return nil, fmt.Errorf("invalid protocol for full-node in config file: %w", protocolErr)
+		}
+
 		var addressInfo host.AddressInfo
 
 		if addressRPC != "" {
@@ -72,6 +77,8 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 			addressInfo = host.AddressInfo{
 				Endpoint: *endpointRPC,
 				Ports:    map[enums.PortType]string{},
+				Protocol: protocol,
+				Instance: node.Instance,
 			}
 
 			if endpointRPC.Port != nil {
@@ -89,6 +96,7 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 			if addressInfo.Endpoint.Address == "" {
 				addressInfo.Endpoint = *endpointMetrics
 				addressInfo.Ports = map[enums.PortType]string{}
+				addressInfo.Instance = node.Instance
 			}
 
 			if endpointMetrics.Port != nil {
@@ -127,7 +135,7 @@ func (c *Controller) getValidatorAddresses(parser addressParser) (addresses []ho
 			return nil, fmt.Errorf("invalid format for validator metrics-address in config file: %w", parseErr)
 		}
 
-		addressInfo := host.AddressInfo{Endpoint: *endpointMetrics, Ports: make(map[enums.PortType]string)}
+		addressInfo := host.AddressInfo{Endpoint: *endpointMetrics, Ports: make(map[enums.PortType]string), Instance: validator.Instance}
 
 		if endpointMetrics.Port != nil {
 			addressInfo.Ports[enums.PortTypeMetrics] = *endpointMetrics.Port
@@ -144,7 +152,7 @@ func (c *Controller) getValidatorAddresses(parser addressParser) (addresses []ho
 // It processes the RPC addresses and initializes the hosts.
 // If the referenceRPCConfig is empty, it returns an error.
 // If the rpc-address is missing for any reference RPC, it returns an error.
-// If there is an error in parsing the reference RPC address, it returns an error.
+// If there is an error in parsing the reference RPC address or protocol, it returns an error.
 // The function appends the processed addresses to the list and returns it along with any encountered error.
 // This function is part of the Controller struct.
 func (c *Controller) getRPCAddresses(parser addressParser) (addresses []host.AddressInfo, err error) {
@@ -154,12 +162,21 @@ func (c *Controller) getRPCAddresses(parser addressParser) (addresses []host.Add
 	}
 
 	for _, rpc := range rpcConfig {
-		endpoint, parseErr := parser(rpc)
+		endpoint, parseErr := parser(rpc.Address)
 		if parseErr != nil {
 			return nil, fmt.Errorf("invalid format for reference-rpc in config file: %w", parseErr)
 		}
 
-		addressInfo := host.AddressInfo{Endpoint: *endpoint, Ports: make(map[enums.PortType]string)}
+		protocol, protocolErr := enums.ParseRPCProtocol(rpc.Protocol)
+		if protocolErr != nil {
+			return nil, fmt.Errorf("invalid protocol for reference-rpc in config file: %w", protocolErr)
+		}
+
+		if protocol == enums.RPCProtocolGRPC {
+			return nil, errors.New("invalid protocol for reference-rpc in config file: grpc is supported for full-nodes only")
+		}
+
+		addressInfo := host.AddressInfo{Endpoint: *endpoint, Ports: make(map[enums.PortType]string), Protocol: protocol}
 		if endpoint.Port != nil {
 			addressInfo.Ports[enums.PortTypeRPC] = *endpoint.Port
 		}
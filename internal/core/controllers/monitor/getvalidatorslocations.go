@@ -0,0 +1,125 @@
+package monitor
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/address"
+)
+
+// maxConcurrentValidatorLookups limits the number of validators resolved and geolocated at the same time.
+const maxConcurrentValidatorLookups = 10
+
+// processValidatorsLocations geolocates the active validators of the reference RPC host through the shared geo gateway.
+// The locations are stored in the metrics of the reference RPC host, keyed by the validator address.
+// The validators whose addresses cannot be resolved or geolocated are left without a location.
+// If the ip-lookup is not configured, a warning is shown and no locations are stored.
+func (c *Controller) processValidatorsLocations(ctx context.Context) error {
+	geoGateway, err := c.getGeoGateway()
+	if err != nil {
+		return err
+	}
+
+	if geoGateway == nil {
+		c.gateways.cli.Warn("ip-lookup is not configured in config file, validators cannot be geolocated")
+
+		return nil
+	}
+
+	c.lock.RLock()
+	validators := c.hosts.rpc[0].Metrics.SystemState.ActiveValidators
+	c.lock.RUnlock()
+
+	locations := make(domainmetrics.ValidatorsLocations, len(validators))
+
+	var (
+		wg            sync.WaitGroup
+		locationsLock sync.Mutex
+		semaphore     = make(chan struct{}, maxConcurrentValidatorLookups)
+	)
+
+	for _, validator := range validators {
+		wg.Add(1)
+
+		go func(validator *domainmetrics.Validator) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			location, ok := getValidatorLocation(ctx, geoGateway, validator)
+			if !ok {
+				return
+			}
+
+			locationsLock.Lock()
+			locations[validator.SuiAddress] = location
+			locationsLock.Unlock()
+		}(validator)
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	c.hosts.rpc[0].Metrics.ValidatorsLocations = locations
+	c.lock.Unlock()
+
+	return nil
+}
+
+// getValidatorLocation parses the network and P2P multiaddrs of the validator, resolves them to IP addresses
+// and geolocates the first public one. The network address is preferred, the P2P address is used as a fallback.
+// It returns false if none of the addresses could be geolocated.
+func getValidatorLocation(ctx context.Context, geoGateway ports.GeoGateway, validator *domainmetrics.Validator) (domainmetrics.ValidatorLocation, bool) {
+	for _, multiaddr := range []string{validator.NetAddress, validator.P2PAddress} {
+		ip := resolvePeerPublicIP(ctx, multiaddr)
+		if ip == nil {
+			continue
+		}
+
+		ipInfo, err := geoGateway.CallFor(ctx, ip)
+		if err != nil || ipInfo == nil {
+			continue
+		}
+
+		location := domainmetrics.ValidatorLocation{
+			IP:      ip.String(),
+			Country: ipInfo.CountryName,
+			ASN:     ipInfo.ASN,
+		}
+
+		if ipInfo.Company != nil {
+			location.Provider = ipInfo.Company.Name
+		}
+
+		return location, true
+	}
+
+	return domainmetrics.ValidatorLocation{}, false
+}
+
+// resolvePeerPublicIP parses the multiaddr and returns the first public IP address it resolves to, or nil if there is none.
+func resolvePeerPublicIP(ctx context.Context, multiaddr string) net.IP {
+	if multiaddr == "" {
+		return nil
+	}
+
+	endpoint, err := address.ParsePeer(multiaddr)
+	if err != nil {
+		return nil
+	}
+
+	ips, err := endpoint.ResolveIPs(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return address.FirstPublicIP(ips)
+}
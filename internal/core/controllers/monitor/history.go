@@ -0,0 +1,106 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/gateways/historygw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	defaultHistoryRetention  = 7 * 24 * time.Hour
+	defaultHistoryMaxSamples = 10_000
+)
+
+// historyTables lists the table types the hosts of which are recorded in the metrics history.
+var historyTables = map[enums.TableType]bool{
+	enums.TableTypeRPC:       true,
+	enums.TableTypeNode:      true,
+	enums.TableTypeValidator: true,
+}
+
+// getHistoryGateway returns the gateway of the metrics history stored in ~/.suimon/history.db, creating it on the first use
+// with the retention limits from the history config section. It returns nil if the recording is disabled with a zero retention.
+func (c *Controller) getHistoryGateway() (ports.HistoryGateway, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gateways.history != nil {
+		return c.gateways.history, nil
+	}
+
+	historyConfig := c.selectedConfig.History
+
+	retention := defaultHistoryRetention
+
+	if retentionValue := historyConfig.Retention; retentionValue != "" {
+		parsedRetention, err := model.ParseDuration(retentionValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history retention in config file: %s", retentionValue)
+		}
+
+		retention = time.Duration(parsedRetention)
+	}
+
+	if retention == 0 {
+		return nil, nil
+	}
+
+	maxSamples := defaultHistoryMaxSamples
+
+	if historyConfig.MaxSamples < 0 {
+		return nil, fmt.Errorf("invalid history max-samples in config file: %d", historyConfig.MaxSamples)
+	}
+
+	if historyConfig.MaxSamples > 0 {
+		maxSamples = historyConfig.MaxSamples
+	}
+
+	historyFile, err := config.HistoryFile()
+	if err != nil {
+		return nil, err
+	}
+
+	c.gateways.history = historygw.NewGateway(historyFile, retention, maxSamples)
+
+	return c.gateways.history, nil
+}
+
+// recordHistory records the status and the key metrics of the hosts of the table type in the metrics history.
+// The history is best effort, so a warning is shown if the samples cannot be recorded.
+func (c *Controller) recordHistory(tableType enums.TableType) error {
+	if !historyTables[tableType] {
+		return nil
+	}
+
+	historyGateway, err := c.getHistoryGateway()
+	if err != nil || historyGateway == nil {
+		return err
+	}
+
+	hosts, err := c.getHostsByTableType(tableType)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	records := make([]ports.HistoryRecord, 0, len(hosts))
+
+	for idx := range hosts {
+		records = append(records, ports.HistoryRecord{
+			Key:    hosts[idx].GetHistoryKey(c.network),
+			Sample: hosts[idx].GetHistorySample(now),
+		})
+	}
+
+	if err := historyGateway.Record(records); err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("failed to record metrics history: %v", err))
+	}
+
+	return nil
+}
@@ -1,11 +1,13 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 const allTablesSelection = "🌐 ALL TABLES"
@@ -14,7 +16,11 @@ const allTablesSelection = "🌐 ALL TABLES"
 // For static monitors, the user is prompted to select the tables to render. Only tables that are enabled
 // in the configuration file are displayed in the list of choices. If no tables are enabled, an error is
 // displayed and the function returns without rendering any tables.
-func (c *Controller) Monitor() error {
+// The provided context is propagated to every network call, canceling it stops the monitor.
+// The options set from the command line are applied to the tables rendered.
+func (c *Controller) Monitor(ctx context.Context, options ports.MonitorOptions) error {
+	c.options = options
+
 	if err := c.chooseConfiguration(); err != nil {
 		return err
 	}
@@ -26,10 +32,10 @@ func (c *Controller) Monitor() error {
 
 	switch selectedMonitorType.Value {
 	case string(enums.MonitorTypeStatic):
-		return c.configureAndRunStaticMonitor()
+		return c.configureAndRunStaticMonitor(ctx)
 
 	case string(enums.MonitorTypeDynamic):
-		return c.configureAndRunDynamicMonitor()
+		return c.configureAndRunDynamicMonitor(ctx)
 
 	default:
 		return fmt.Errorf("not supported monitoring type provided %s", selectedMonitorType.Value)
@@ -75,7 +81,7 @@ func (c *Controller) chooseMonitorType() (*cligw.SelectChoice, error) {
 // If no tables are selected (i.e., tablesToRender is nil), it returns nil.
 // Otherwise, it sets the selected tables to the Controller's selectedTables field and calls the Static method.
 // It returns any error that occurs during the execution of the Static method.
-func (c *Controller) configureAndRunStaticMonitor() error {
+func (c *Controller) configureAndRunStaticMonitor(ctx context.Context) error {
 	tablesToRender, err := c.selectStaticTables()
 	if err != nil {
 		return err
@@ -87,7 +93,7 @@ func (c *Controller) configureAndRunStaticMonitor() error {
 
 	c.selectedTables = tablesToRender
 
-	return c.Static()
+	return c.Static(ctx)
 }
 
 // configureAndRunDynamicMonitor is a method of the Controller struct that configures and runs a dynamic monitor.
@@ -96,7 +102,7 @@ func (c *Controller) configureAndRunStaticMonitor() error {
 // If no dashboard is selected (i.e., dashboardToRender is nil), it returns nil.
 // Otherwise, it sets the selected dashboard to the Controller's selectedDashboard field and calls the Dynamic method.
 // It returns any error that occurs during the execution of the Dynamic method.
-func (c *Controller) configureAndRunDynamicMonitor() error {
+func (c *Controller) configureAndRunDynamicMonitor(ctx context.Context) error {
 	dashboardToRender, err := c.selectDynamicDashboard()
 	if err != nil {
 		return err
@@ -108,7 +114,7 @@ func (c *Controller) configureAndRunDynamicMonitor() error {
 
 	c.selectedDashboard = *dashboardToRender
 
-	return c.Dynamic()
+	return c.Dynamic(ctx)
 }
 
 // selectStaticTables prompts the user to select the static tables to render.
@@ -125,8 +131,13 @@ func (c *Controller) selectStaticTables() ([]enums.TableType, error) {
 		string(enums.TableTypeProtocol),
 		string(enums.TableTypeValidatorParams),
 		string(enums.TableTypeValidatorsAtRisk),
+		string(enums.TableTypeAtRiskProjection),
 		string(enums.TableTypeValidatorReports),
 		string(enums.TableTypeActiveValidators),
+		string(enums.TableTypeNextEpochChanges),
+		string(enums.TableTypeDecentralization),
+		string(enums.TableTypeStakeDistribution),
+		string(enums.TableTypeValidatorSet),
 		string(enums.TableTypeReleases),
 	)
 
@@ -155,8 +166,13 @@ func (c *Controller) selectStaticTables() ([]enums.TableType, error) {
 				enums.TableTypeProtocol,
 				enums.TableTypeValidatorParams,
 				enums.TableTypeValidatorsAtRisk,
+				enums.TableTypeAtRiskProjection,
 				enums.TableTypeValidatorReports,
 				enums.TableTypeActiveValidators,
+				enums.TableTypeNextEpochChanges,
+				enums.TableTypeDecentralization,
+				enums.TableTypeStakeDistribution,
+				enums.TableTypeValidatorSet,
 				enums.TableTypeReleases,
 			)
 
@@ -1,24 +1,28 @@
 package monitor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
 	"sync"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
 	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
 	"github.com/bartosian/suimon/internal/pkg/progress"
 )
 
 var rpcTables = map[enums.TableType]bool{
 	enums.TableTypeActiveValidators:   true,
+	enums.TableTypeNextEpochChanges:   true,
 	enums.TableTypeValidatorReports:   true,
 	enums.TableTypeValidatorsAtRisk:   true,
+	enums.TableTypeAtRiskProjection:   true,
 	enums.TableTypeGasPriceAndSubsidy: true,
 	enums.TableTypeValidatorParams:    true,
 	enums.TableTypeRPC:                true,
-	enums.TableTypeProtocol:           true,
+	enums.TableTypeStakeDistribution:  true,
 }
 
 // ParseConfigData retrieves data from hosts and sets their health based on the selected tables.
@@ -26,10 +30,10 @@ var rpcTables = map[enums.TableType]bool{
 // retrieves data from the selected tables in parallel using goroutines. For each selected table, the function
 // retrieves data from the hosts and sets their health. Any errors that occur during this process are sent to
 // a channel. If an error is received from the channel, it is returned immediately. If no errors are received,
-// the function returns nil.
-func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
+// the function returns nil. Canceling the provided context aborts all the requests still in flight.
+func (c *Controller) ParseConfigData(ctx context.Context, monitorType enums.MonitorType) error {
 	if len(c.selectedTables) == 0 || (len(c.selectedTables) > 1 || c.selectedTables[0] != enums.TableTypeReleases) {
-		if err := c.ParseConfigRPC(); err != nil {
+		if err := c.ParseConfigRPC(ctx); err != nil {
 			return err
 		}
 	}
@@ -46,7 +50,7 @@ func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
 		go func(table enums.TableType) {
 			defer wg.Done()
 
-			if err := c.getTableData(table); err != nil {
+			if err := c.getTableData(ctx, table); err != nil {
 				errChan <- fmt.Errorf("error processing table %s: %w", table, err)
 			}
 		}(tableType)
@@ -55,6 +59,10 @@ func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
 	wg.Wait()
 	close(errChan)
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	return checkErrors(errChan)
 }
 
@@ -95,8 +103,8 @@ func checkErrors(errChan <-chan error) error {
 
 // ParseConfigRPC fetches hosts data for the RPC table, sorts the hosts in
 // alphabetical order, and sets their health status.
-func (c *Controller) ParseConfigRPC() error {
-	if err := c.getTableData(enums.TableTypeRPC); err != nil {
+func (c *Controller) ParseConfigRPC(ctx context.Context) error {
+	if err := c.getTableData(ctx, enums.TableTypeRPC); err != nil {
 		return err
 	}
 
@@ -114,42 +122,86 @@ func (c *Controller) ParseConfigRPC() error {
 // getTableData fetches the data for the specified table type.
 // It uses a progress bar to indicate the progress of the data fetching process.
 // If the table type is 'Releases', it processes the releases data.
+// If the table type is 'Decentralization', it geolocates the active validators.
+// If the table type is 'Protocol', it fetches the protocol config of the previous version to compare with.
+// If the table type is 'Validator Set', it fetches the pending validators and compares the active ones with the last snapshot.
 // For other table types, it processes the data accordingly.
 // The function returns an error if there is an issue fetching or processing the data.
-func (c *Controller) getTableData(tableType enums.TableType) error {
+func (c *Controller) getTableData(ctx context.Context, tableType enums.TableType) error {
 	progressChan := progress.NewProgressBar("PARSING DATA FOR "+string(tableType), progress.ColorBlue)
 	defer func() { progressChan <- struct{}{} }()
 
 	if tableType == enums.TableTypeReleases {
-		return c.processReleases()
+		return c.processReleases(ctx)
+	}
+
+	if tableType == enums.TableTypeDecentralization {
+		return c.processValidatorsLocations(ctx)
+	}
+
+	if tableType == enums.TableTypeProtocol {
+		return c.processPreviousProtocol(ctx)
+	}
+
+	if tableType == enums.TableTypeValidatorSet {
+		return c.processValidatorSet(ctx)
 	}
 
-	return c.processStandardTableTypes(tableType)
+	return c.processStandardTableTypes(ctx, tableType)
 }
 
 // processReleases fetches the release data for the current network.
 // It stores the fetched releases in the Controller's state and returns any error encountered during the process.
-func (c *Controller) processReleases() error {
-	releases, err := domainmetrics.GetReleases(c.network)
-	if err != nil {
+func (c *Controller) processReleases(ctx context.Context) error {
+	if _, err := c.getReleases(ctx); err != nil {
 		return fmt.Errorf("error getting releases: %w", err)
 	}
 
-	c.releases = releases
+	return nil
+}
+
+// processPreviousProtocol fetches the protocol config of the version preceding the current one from the reference RPC host,
+// so the feature flags and attributes changed by the current version can be highlighted.
+// The comparison is optional, so a warning is shown if the previous version cannot be fetched.
+func (c *Controller) processPreviousProtocol(ctx context.Context) error {
+	c.lock.RLock()
+	rpcHost := c.hosts.rpc[0]
+	c.lock.RUnlock()
+
+	previousVersion, ok := rpcHost.Metrics.Protocol.GetPreviousVersion()
+	if !ok {
+		return nil
+	}
+
+	previousProtocol, err := rpcHost.GetProtocolConfig(ctx, previousVersion)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.gateways.cli.Warn(fmt.Sprintf("protocol changes cannot be highlighted: %v", err))
+
+		return nil
+	}
+
+	c.lock.Lock()
+	c.hosts.rpc[0].Metrics.PreviousProtocol = previousProtocol
+	c.lock.Unlock()
 
 	return nil
 }
 
 // processStandardTableTypes fetches the data for the specified table type other than 'Releases'.
-// It retrieves the address information based on the table type, creates hosts, sets the hosts by table type, and sets their health status.
+// It retrieves the address information based on the table type, creates hosts, sets the hosts by table type, sets their health status,
+// compares their versions with the releases and records them in the metrics history.
 // The function returns an error if there is an issue fetching the address information, creating hosts, setting hosts by table type, or setting their health status.
-func (c *Controller) processStandardTableTypes(tableType enums.TableType) error {
+func (c *Controller) processStandardTableTypes(ctx context.Context, tableType enums.TableType) error {
 	addresses, err := c.getAddressInfoByTableType(tableType)
 	if err != nil {
 		return fmt.Errorf("error getting address info: %w", err)
 	}
 
-	hosts, err := c.createHosts(tableType, addresses)
+	hosts, err := c.createHosts(ctx, tableType, addresses)
 	if err != nil {
 		return fmt.Errorf("error creating hosts: %w", err)
 	}
@@ -158,7 +210,15 @@ func (c *Controller) processStandardTableTypes(tableType enums.TableType) error
 		return fmt.Errorf("error setting hosts by table type: %w", err)
 	}
 
-	return c.setHostsHealth(tableType)
+	if err = c.setHostsHealth(tableType); err != nil {
+		return err
+	}
+
+	if err = c.setHostsVersionDrift(ctx, tableType); err != nil {
+		return err
+	}
+
+	return c.recordHistory(tableType)
 }
 
 // sortHosts sorts the active hosts for the specified table type based on their corresponding metric values.
@@ -182,11 +242,46 @@ func (c *Controller) sortHosts(tableType enums.TableType) error {
 		sort.SliceStable(hosts, func(left, right int) bool {
 			return hosts[left].Metrics.TotalTransactionsBlocks > hosts[right].Metrics.TotalTransactionsBlocks
 		})
+
+		if tableType == enums.TableTypeRPC {
+			sortReferenceHosts(hosts)
+		}
 	}
 
 	return nil
 }
 
+// sortReferenceHosts orders the reference RPC hosts so that the first one is used as the reference.
+// Healthy hosts, the ones that are not lagging behind the most advanced host by more than the allowed
+// number of checkpoints, go first and are ordered by their average RPC latency, lowest first.
+func sortReferenceHosts(hosts []domainhost.Host) {
+	var latestCheckpoint int
+
+	for idx := range hosts {
+		if hosts[idx].Metrics.LatestCheckpoint > latestCheckpoint {
+			latestCheckpoint = hosts[idx].Metrics.LatestCheckpoint
+		}
+	}
+
+	isHealthy := func(host *domainhost.Host) bool {
+		return host.Metrics.Updated &&
+			host.Metrics.TotalTransactionsBlocks > 0 &&
+			host.Metrics.LatestCheckpoint >= latestCheckpoint-domainmetrics.LatestCheckpointLag
+	}
+
+	sort.SliceStable(hosts, func(left, right int) bool {
+		leftHealthy, rightHealthy := isHealthy(&hosts[left]), isHealthy(&hosts[right])
+		if leftHealthy != rightHealthy {
+			return leftHealthy
+		}
+
+		leftLatency := hosts[left].GetLatency(enums.PortTypeRPC).Avg
+		rightLatency := hosts[right].GetLatency(enums.PortTypeRPC).Avg
+
+		return leftLatency < rightLatency
+	})
+}
+
 // setHostsHealth retrieves the latest health information for all active hosts and updates the CheckerController's internal state with the new information.
 // The function retrieves health information for each host in parallel and sets the corresponding health status in the internal state.
 // Returns an error if the health information cannot be retrieved from any of the active hosts or if there is an issue updating the CheckerController's internal state.
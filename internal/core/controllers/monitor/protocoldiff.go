@@ -0,0 +1,99 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// DiffProtocol prompts the user to select a configuration, fetches the protocol configs of the versions requested
+// from the reference RPC host and renders the feature flags and attributes added, removed or changed between them.
+// By default, the current protocol version is compared with the maximum one supported by the network, previewing the upcoming upgrade.
+// It returns an error if a version is not set and the reference RPC host does not report its default, e.g. the maximum version over GraphQL.
+func (c *Controller) DiffProtocol(ctx context.Context, query ports.ProtocolDiffQuery) error {
+	if err := c.chooseConfiguration(); err != nil {
+		return err
+	}
+
+	if err := c.ParseConfigRPC(ctx); err != nil {
+		return err
+	}
+
+	c.lock.RLock()
+	rpcHost := c.hosts.rpc[0]
+	c.lock.RUnlock()
+
+	current := rpcHost.Metrics.Protocol
+
+	fromVersion, toVersion := query.From, query.To
+	if fromVersion == "" {
+		fromVersion = current.ProtocolVersion
+	}
+
+	if toVersion == "" && current.HasSupportedVersions() {
+		toVersion = current.MaxSupportedProtocolVersion
+	}
+
+	if fromVersion == "" {
+		return errors.New("current protocol version is not available from the reference rpc, set it with --from")
+	}
+
+	if toVersion == "" {
+		return errors.New("maximum supported protocol version is not available from the reference rpc, set it with --to")
+	}
+
+	if fromVersion == toVersion {
+		message := fmt.Sprintf("version %s is compared with itself", fromVersion)
+		if current.HasSupportedVersions() {
+			message = fmt.Sprintf("%s, the network supports versions %s - %s", message, current.MinSupportedProtocolVersion, current.MaxSupportedProtocolVersion)
+		}
+
+		c.gateways.cli.Info("NO PROTOCOL CHANGES", message)
+
+		return nil
+	}
+
+	fromProtocol, err := c.getProtocolVersion(ctx, &rpcHost, fromVersion)
+	if err != nil {
+		return err
+	}
+
+	toProtocol, err := c.getProtocolVersion(ctx, &rpcHost, toVersion)
+	if err != nil {
+		return err
+	}
+
+	rpcHost.Metrics.Protocol = *toProtocol
+	rpcHost.Metrics.PreviousProtocol = fromProtocol
+
+	builder := tablebuilder.NewBuilder(enums.TableTypeProtocolDiff, []domainhost.Host{rpcHost}, nil, c.gateways.cli)
+	builder.SetProtocolFilter(query.Filter)
+
+	if err := builder.Init(); err != nil {
+		return err
+	}
+
+	return builder.Render()
+}
+
+// getProtocolVersion returns the protocol config of the version, reusing the current one already fetched from the host.
+func (c *Controller) getProtocolVersion(ctx context.Context, host *domainhost.Host, version string) (*domainmetrics.Protocol, error) {
+	if version == host.Metrics.Protocol.ProtocolVersion {
+		protocol := host.Metrics.Protocol
+
+		return &protocol, nil
+	}
+
+	protocol, err := host.GetProtocolConfig(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("error getting protocol config of version %s: %w", version, err)
+	}
+
+	return protocol, nil
+}
@@ -0,0 +1,131 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/pkg/markdown"
+)
+
+// ShowRelease prompts the user to select a configuration and prints the release with the tag fetched from the releases
+// configured for it: the protocol version bump, the notes marking the upgrade as mandatory or breaking, and the release notes.
+func (c *Controller) ShowRelease(ctx context.Context, tag string) error {
+	if err := c.chooseConfiguration(); err != nil {
+		return err
+	}
+
+	releaseGateway, _, err := c.getReleaseGateway()
+	if err != nil {
+		return err
+	}
+
+	release, err := releaseGateway.Release(ctx, tag)
+	if err != nil {
+		return err
+	}
+
+	c.gateways.cli.Info("RELEASE", release.Name)
+	c.gateways.cli.Info("URL", release.URL)
+	c.showReleaseNotes(release)
+
+	fmt.Println()
+	fmt.Println(markdown.Render(release.Body, domainmetrics.UpgradeNoteRegexp))
+
+	return nil
+}
+
+// ShowReleaseChanges prompts the user to select a configuration and lists, for every version run by its full nodes and validators,
+// the releases published since that version up to the latest one, oldest first, with their protocol version bumps and upgrade notes.
+func (c *Controller) ShowReleaseChanges(ctx context.Context) error {
+	if err := c.chooseConfiguration(); err != nil {
+		return err
+	}
+
+	c.selectedTables = []enums.TableType{enums.TableTypeNode, enums.TableTypeValidator}
+
+	if err := c.ParseConfigData(ctx, enums.MonitorTypeStatic); err != nil {
+		return err
+	}
+
+	releases, err := c.getReleases(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting releases: %w", err)
+	}
+
+	versions, addresses := c.getHostsByVersion()
+	if len(versions) == 0 {
+		return errors.New("no full nodes or validators reporting their version")
+	}
+
+	for _, version := range versions {
+		c.gateways.cli.Info("VERSION "+version, strings.Join(addresses[version], ", "))
+
+		releasesSince, ok := domainmetrics.GetReleasesSince(releases, version)
+		if !ok {
+			c.gateways.cli.Warn(fmt.Sprintf("version %s is not in the %d releases fetched", version, len(releases)))
+
+			continue
+		}
+
+		if len(releasesSince) == 0 {
+			c.gateways.cli.Info("UP TO DATE", "no releases published since")
+
+			continue
+		}
+
+		for idx := len(releasesSince) - 1; idx >= 0; idx-- {
+			release := releasesSince[idx]
+
+			c.gateways.cli.Info("RELEASE", fmt.Sprintf("%s %s", release.TagName, release.PublishedAt))
+			c.showReleaseNotes(&release)
+		}
+	}
+
+	return nil
+}
+
+// showReleaseNotes prints the protocol version bump and the upgrade notes of the release.
+func (c *Controller) showReleaseNotes(release *domainmetrics.Release) {
+	notes := release.GetNotes()
+
+	if notes.ProtocolVersion != "" {
+		c.gateways.cli.Info("PROTOCOL VERSION", notes.ProtocolVersion)
+	}
+
+	for _, note := range notes.UpgradeNotes {
+		c.gateways.cli.Warn("UPGRADE NOTE: " + markdown.Plain(note))
+	}
+}
+
+// getHostsByVersion returns the versions run by the full nodes and validators in the order they are first seen,
+// and the addresses of the hosts running each of them. The hosts not reporting their version are skipped.
+func (c *Controller) getHostsByVersion() ([]string, map[string][]string) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var versions []string
+
+	addresses := make(map[string][]string)
+
+	for _, hosts := range [][]domainhost.Host{c.hosts.node, c.hosts.validator} {
+		for idx := range hosts {
+			version := hosts[idx].Metrics.Version
+			if version == "" {
+				continue
+			}
+
+			if _, ok := addresses[version]; !ok {
+				versions = append(versions, version)
+			}
+
+			addresses[version] = append(addresses[version], hosts[idx].Endpoint.Address)
+		}
+	}
+
+	return versions, addresses
+}
@@ -0,0 +1,127 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/gateways/releasegw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/diskcache"
+)
+
+const (
+	defaultReleasesRepository = "MystenLabs/sui"
+	defaultReleasesAPIURL     = "https://api.github.com"
+	defaultReleasesCount      = 50
+	releasesTokenEnvVar       = "GITHUB_TOKEN"
+	releasesCacheDir          = "releases"
+
+	// releasesCacheTTL is how long the pages of releases are kept to be revalidated with their ETag, or used if the API cannot be reached.
+	releasesCacheTTL = 30 * 24 * time.Hour
+)
+
+// getReleases returns the releases of the current network, fetching them once for all the tables requiring them.
+func (c *Controller) getReleases(ctx context.Context) ([]domainmetrics.Release, error) {
+	c.releasesLock.Lock()
+	defer c.releasesLock.Unlock()
+
+	if c.releases != nil {
+		return c.releases, nil
+	}
+
+	releaseGateway, query, err := c.getReleaseGateway()
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := releaseGateway.Releases(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.releases = releases
+
+	return releases, nil
+}
+
+// getReleaseGateway returns the gateway of the releases configured in the releases config section, creating it on the first use,
+// and the query selecting the releases of the current network. The releases of MystenLabs/sui with the name starting with
+// the network name are fetched by default. The token is read from the GITHUB_TOKEN environment variable if not set in the config.
+func (c *Controller) getReleaseGateway() (ports.ReleaseGateway, ports.ReleaseQuery, error) {
+	releasesConfig := c.selectedConfig.Releases
+
+	pattern := "^" + regexp.QuoteMeta(c.network)
+	if releasesConfig.Pattern != "" {
+		pattern = releasesConfig.Pattern
+	}
+
+	patternRegexp, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, ports.ReleaseQuery{}, fmt.Errorf("invalid releases pattern in config file: %s", pattern)
+	}
+
+	if releasesConfig.Count < 0 {
+		return nil, ports.ReleaseQuery{}, fmt.Errorf("invalid releases count in config file: %d", releasesConfig.Count)
+	}
+
+	query := ports.ReleaseQuery{
+		Pattern: patternRegexp,
+		Count:   defaultReleasesCount,
+	}
+
+	if releasesConfig.Count > 0 {
+		query.Count = releasesConfig.Count
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gateways.release != nil {
+		return c.gateways.release, query, nil
+	}
+
+	repository := defaultReleasesRepository
+	if releasesConfig.Repository != "" {
+		repository = releasesConfig.Repository
+	}
+
+	apiURL := defaultReleasesAPIURL
+	if releasesConfig.APIURL != "" {
+		apiURL = releasesConfig.APIURL
+	}
+
+	token := releasesConfig.Token
+	if token == "" {
+		token = os.Getenv(releasesTokenEnvVar)
+	}
+
+	c.gateways.release = releasegw.NewGateway(c.gateways.cli, apiURL, repository, token, c.getReleasesCache())
+
+	return c.gateways.release, query, nil
+}
+
+// getReleasesCache creates the on-disk cache of the releases under ~/.suimon/cache.
+// It returns nil if the cache directory cannot be created, in which case a warning is shown.
+func (c *Controller) getReleasesCache() *diskcache.Cache {
+	cacheDir, err := config.CacheDir()
+	if err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("releases cache disabled: %v", err))
+
+		return nil
+	}
+
+	releasesCache, err := diskcache.New(filepath.Join(cacheDir, releasesCacheDir), releasesCacheTTL)
+	if err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("releases cache disabled: %v", err))
+
+		return nil
+	}
+
+	return releasesCache
+}
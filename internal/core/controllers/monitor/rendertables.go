@@ -17,6 +17,8 @@ func (c *Controller) RenderTables() error {
 	nodeProvided := len(c.hosts.node) > 0
 	validatorProvided := len(c.hosts.validator) > 0
 	releasesProvided := len(c.releases) > 0
+	locationsProvided := rpcProvided && len(c.hosts.rpc[0].Metrics.ValidatorsLocations) > 0
+	validatorSetProvided := rpcProvided && c.hosts.rpc[0].Metrics.ValidatorSet != nil
 
 	tableTypeEnabled := map[enums.TableType]bool{
 		enums.TableTypeRPC:                rpcProvided,
@@ -26,9 +28,14 @@ func (c *Controller) RenderTables() error {
 		enums.TableTypeProtocol:           rpcProvided,
 		enums.TableTypeValidatorParams:    rpcProvided,
 		enums.TableTypeValidatorsAtRisk:   rpcProvided,
+		enums.TableTypeAtRiskProjection:   rpcProvided,
 		enums.TableTypeValidatorReports:   rpcProvided,
 		enums.TableTypeActiveValidators:   rpcProvided,
+		enums.TableTypeNextEpochChanges:   rpcProvided,
 		enums.TableTypeReleases:           releasesProvided,
+		enums.TableTypeDecentralization:   locationsProvided,
+		enums.TableTypeStakeDistribution:  rpcProvided,
+		enums.TableTypeValidatorSet:       validatorSetProvided,
 	}
 
 	for _, tableType := range selectedTables {
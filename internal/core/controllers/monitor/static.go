@@ -1,6 +1,7 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
@@ -11,9 +12,9 @@ import (
 
 // Static is a method of the Controller struct, responsible for initializing and rendering tables
 // based on the configuration data.
-func (c *Controller) Static() error {
+func (c *Controller) Static(ctx context.Context) error {
 	// Parse the configuration data.
-	if err := c.ParseConfigData(enums.MonitorTypeStatic); err != nil {
+	if err := c.ParseConfigData(ctx, enums.MonitorTypeStatic); err != nil {
 		return err
 	}
 
@@ -46,6 +47,7 @@ func (c *Controller) InitTables() error {
 		}
 
 		builder := tablebuilder.NewBuilder(tableType, hosts, releases, c.gateways.cli)
+		builder.SetProtocolFilter(c.options.ProtocolFilter)
 		c.builders.static[tableType] = builder
 
 		if err := builder.Init(); err != nil {
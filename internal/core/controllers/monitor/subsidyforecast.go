@@ -0,0 +1,84 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/asciichart"
+)
+
+const (
+	forecastChartHeight = 10
+	forecastTimeLayout  = "2006-01-02 15:04 MST"
+)
+
+// ForecastSubsidy prompts the user to select a configuration and forecasts the stake subsidy distributions from the
+// system state of the reference RPC host. It renders the subsidy periods forecast as a table, followed by the next decrease
+// of the distribution, the depletion of the subsidy fund and the chart of the amount distributed per epoch.
+// The chart is skipped when no subsidy period completes within the forecast horizon.
+func (c *Controller) ForecastSubsidy(ctx context.Context, query ports.SubsidyForecastQuery) error {
+	if err := c.chooseConfiguration(); err != nil {
+		return err
+	}
+
+	if err := c.ParseConfigRPC(ctx); err != nil {
+		return err
+	}
+
+	c.lock.RLock()
+	rpcHost := c.hosts.rpc[0]
+	c.lock.RUnlock()
+
+	forecast, err := domainmetrics.NewSubsidyForecast(&rpcHost.Metrics.SystemState, query.Periods, query.Stake)
+	if err != nil {
+		return err
+	}
+
+	rpcHost.Metrics.SubsidyForecast = forecast
+
+	builder := tablebuilder.NewBuilder(enums.TableTypeSubsidyForecast, []domainhost.Host{rpcHost}, nil, c.gateways.cli)
+
+	if err := builder.Init(); err != nil {
+		return err
+	}
+
+	if err := builder.Render(); err != nil {
+		return err
+	}
+
+	if forecast.NextDecreaseEpoch != 0 {
+		c.gateways.cli.Info("NEXT SUBSIDY DECREASE", fmt.Sprintf("at the end of epoch %d, est. %s, from %s to %s SUI per epoch",
+			forecast.NextDecreaseEpoch, forecast.GetEpochStart(forecast.NextDecreaseEpoch+1).UTC().Format(forecastTimeLayout),
+			formatForecastAmount(forecast.CurrentDistributionSui), formatForecastAmount(forecast.NextDistributionSui)))
+	}
+
+	if forecast.Depleted {
+		c.gateways.cli.Info("SUBSIDY FUND DEPLETION", fmt.Sprintf("at the end of epoch %d, est. %s",
+			forecast.DepletionEpoch, forecast.GetEpochStart(forecast.DepletionEpoch+1).UTC().Format(forecastTimeLayout)))
+	} else {
+		c.gateways.cli.Info("SUBSIDY FUND DEPLETION", fmt.Sprintf("not within the next %d epochs", domainmetrics.MaxSubsidyForecastEpochs))
+	}
+
+	if len(forecast.Periods) == 0 {
+		c.gateways.cli.Info("DISTRIBUTION PER EPOCH, SUI", fmt.Sprintf("no subsidy period completes within the next %d epochs", domainmetrics.MaxSubsidyForecastEpochs))
+
+		return nil
+	}
+
+	c.gateways.cli.Info("DISTRIBUTION PER EPOCH, SUI", fmt.Sprintf("epochs %d - %d", forecast.Periods[0].StartEpoch, forecast.Periods[len(forecast.Periods)-1].EndEpoch))
+
+	fmt.Println(asciichart.Plot(forecast.DistributionsPerEpochSui, forecastChartHeight, query.Width))
+
+	return nil
+}
+
+// formatForecastAmount formats the amount of SUI rounded to whole SUI.
+func formatForecastAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 0, 64)
+}
@@ -0,0 +1,93 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/gateways/snapshotgw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// maxValidatorSetSnapshots is the number of the epochs the validator set snapshots are kept for per network.
+const maxValidatorSetSnapshots = 30
+
+// processValidatorSet fetches the pending active validators and the validator candidates from the reference RPC host
+// and resolves the pending removals. The active validators are compared with the snapshot of the latest earlier epoch
+// stored in ~/.suimon/snapshots to find the validators joined and left, and the snapshot of the current epoch is stored.
+// The pending validators and the snapshots are optional, so a warning is shown if they cannot be fetched or stored.
+func (c *Controller) processValidatorSet(ctx context.Context) error {
+	c.lock.RLock()
+	rpcHost := c.hosts.rpc[0]
+	c.lock.RUnlock()
+
+	systemState := &rpcHost.Metrics.SystemState
+
+	validatorSet, err := rpcHost.GetValidatorSet(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.gateways.cli.Warn(fmt.Sprintf("pending validators cannot be shown: %v", err))
+
+		if validatorSet, err = domainmetrics.NewValidatorSet(systemState, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := c.compareValidatorSetSnapshot(validatorSet, systemState); err != nil {
+		c.gateways.cli.Warn(fmt.Sprintf("validator set changes since the last epoch cannot be shown: %v", err))
+	}
+
+	c.lock.Lock()
+	c.hosts.rpc[0].Metrics.ValidatorSet = validatorSet
+	c.lock.Unlock()
+
+	return nil
+}
+
+// compareValidatorSetSnapshot sets the validators joined and left since the snapshot of the latest earlier epoch, if any,
+// and stores the snapshot of the current epoch.
+func (c *Controller) compareValidatorSetSnapshot(validatorSet *domainmetrics.ValidatorSet, systemState *domainmetrics.SuiSystemState) error {
+	snapshotGateway, err := c.getSnapshotGateway()
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := snapshotGateway.ValidatorSetSnapshots(c.network)
+	if err != nil {
+		return err
+	}
+
+	for idx := len(snapshots) - 1; idx >= 0; idx-- {
+		if snapshots[idx].Epoch != systemState.Epoch {
+			validatorSet.SetChangesSince(&snapshots[idx], systemState)
+
+			break
+		}
+	}
+
+	return snapshotGateway.SaveValidatorSetSnapshot(domainmetrics.NewValidatorSetSnapshot(c.network, systemState, time.Now()))
+}
+
+// getSnapshotGateway returns the gateway storing the validator set snapshots in ~/.suimon/snapshots, creating it on the first use.
+func (c *Controller) getSnapshotGateway() (ports.SnapshotGateway, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.gateways.snapshot != nil {
+		return c.gateways.snapshot, nil
+	}
+
+	snapshotsDir, err := config.SnapshotsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	c.gateways.snapshot = snapshotgw.NewGateway(snapshotsDir, maxValidatorSetSnapshots)
+
+	return c.gateways.snapshot, nil
+}
@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// versionDriftTables lists the tables with the hosts reporting the version they run.
+var versionDriftTables = map[enums.TableType]bool{
+	enums.TableTypeNode:      true,
+	enums.TableTypeValidator: true,
+}
+
+// setHostsVersionDrift compares the versions run by the hosts of the table with the releases of the network.
+// The comparison is optional, so a warning is shown if the releases cannot be fetched.
+func (c *Controller) setHostsVersionDrift(ctx context.Context, tableType enums.TableType) error {
+	if !versionDriftTables[tableType] {
+		return nil
+	}
+
+	releases, err := c.getReleases(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.gateways.cli.Warn(fmt.Sprintf("versions cannot be compared with the releases: %v", err))
+
+		return nil
+	}
+
+	hosts, err := c.getHostsByTableType(tableType)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for idx := range hosts {
+		hosts[idx].SetVersionDrift(releases, now)
+	}
+
+	return nil
+}
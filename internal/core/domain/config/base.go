@@ -12,20 +12,52 @@ import (
 const (
 	suimonConfigEnvVar = "SUIMON_CONFIG_PATH"
 	suimonConfigDir    = ".suimon"
+	suimonCacheDir     = "cache"
+	suimonHistoryFile  = "history.db"
+	suimonReportsDir   = "reports"
+	suimonSnapshotsDir = "snapshots"
 )
 
 type Config struct {
 	IPLookup struct {
-		AccessToken string `yaml:"access-token"`
+		Provider    string   `yaml:"provider"`
+		AccessToken string   `yaml:"access-token"`
+		Databases   []string `yaml:"databases"`
+		CacheTTL    string   `yaml:"cache-ttl"`
 	} `yaml:"ip-lookup"`
-	ReferenceRPC []string `yaml:"reference-rpc"`
+	Prometheus struct {
+		URL           string            `yaml:"url"`
+		InstanceLabel string            `yaml:"instance-label"`
+		RateRange     string            `yaml:"rate-range"`
+		Labels        map[string]string `yaml:"labels"`
+	} `yaml:"prometheus"`
+	ReferenceRPC []ReferenceRPC `yaml:"reference-rpc"`
 	FullNodes    []struct {
 		JSONRPCAddress string `yaml:"json-rpc-address"`
 		MetricsAddress string `yaml:"metrics-address"`
+		Protocol       string `yaml:"protocol"`
+		Instance       string `yaml:"instance"`
 	} `yaml:"full-nodes"`
 	Validators []struct {
 		MetricsAddress string `yaml:"metrics-address"`
+		Instance       string `yaml:"instance"`
 	} `yaml:"validators"`
+	CustomMetrics []CustomMetric `yaml:"custom-metrics"`
+	Rates         struct {
+		Mode   string `yaml:"mode"`
+		Window string `yaml:"window"`
+	} `yaml:"rates"`
+	History struct {
+		Retention  string `yaml:"retention"`
+		MaxSamples int    `yaml:"max-samples"`
+	} `yaml:"history"`
+	Releases struct {
+		Repository string `yaml:"repository"`
+		APIURL     string `yaml:"api-url"`
+		Token      string `yaml:"token"`
+		Pattern    string `yaml:"pattern"`
+		Count      int    `yaml:"count"`
+	} `yaml:"releases"`
 }
 
 // NewConfig reads the Suimon configuration files from the directory specified by
@@ -35,17 +67,67 @@ type Config struct {
 func NewConfig() (map[string]Config, error) {
 	dirPath := os.Getenv(suimonConfigEnvVar)
 	if dirPath == "" {
-		homeDir, err := os.UserHomeDir()
+		dataDir, err := DataDir()
 		if err != nil {
 			return nil, err
 		}
 
-		dirPath = filepath.Join(homeDir, suimonConfigDir)
+		dirPath = dataDir
 	}
 
 	return readConfigs(dirPath)
 }
 
+// DataDir returns the directory suimon keeps its data in, ~/.suimon.
+func DataDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, suimonConfigDir), nil
+}
+
+// CacheDir returns the directory the suimon caches are stored in, ~/.suimon/cache.
+func CacheDir() (string, error) {
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, suimonCacheDir), nil
+}
+
+// HistoryFile returns the path of the file the metrics history is stored in, ~/.suimon/history.db.
+func HistoryFile() (string, error) {
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, suimonHistoryFile), nil
+}
+
+// ReportsDir returns the directory the epoch reports are saved in, ~/.suimon/reports.
+func ReportsDir() (string, error) {
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, suimonReportsDir), nil
+}
+
+// SnapshotsDir returns the directory the validator set snapshots are stored in, ~/.suimon/snapshots.
+func SnapshotsDir() (string, error) {
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, suimonSnapshotsDir), nil
+}
+
 // readConfigs reads the Suimon configuration files from the specified directory,
 // creates a map of Config objects with the file name segments as the keys, and returns
 // the map. The file name segments are converted to uppercase before being used as keys.
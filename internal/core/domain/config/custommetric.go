@@ -0,0 +1,16 @@
+package config
+
+// CustomMetric represents a Prometheus metric requested in addition to the built-in ones.
+// Each custom metric is shown as an extra column in the tables listed, or in both the node and validator tables
+// if no tables are listed, and optionally as a dashboard cell. The aliases are tried in order if the metric is not exposed under its name.
+type CustomMetric struct {
+	Name        string            `yaml:"name"`
+	Aliases     []string          `yaml:"aliases"`
+	Labels      map[string]string `yaml:"labels"`
+	Type        string            `yaml:"type"`
+	DisplayName string            `yaml:"display-name"`
+	Unit        string            `yaml:"unit"`
+	Rate        string            `yaml:"rate"`
+	Tables      []string          `yaml:"tables"`
+	Dashboard   bool              `yaml:"dashboard"`
+}
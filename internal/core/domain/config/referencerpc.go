@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReferenceRPC represents a reference RPC endpoint and the protocol used to query it.
+// In the config file it is either a plain address or a mapping with address and protocol keys.
+type ReferenceRPC struct {
+	Address  string `yaml:"address"`
+	Protocol string `yaml:"protocol"`
+}
+
+// UnmarshalYAML decodes a reference RPC entry given either as a scalar address or as a mapping.
+func (rpc *ReferenceRPC) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Decode(&rpc.Address)
+	case yaml.MappingNode:
+		type rawReferenceRPC ReferenceRPC
+
+		var raw rawReferenceRPC
+		if err := node.Decode(&raw); err != nil {
+			return err
+		}
+
+		*rpc = ReferenceRPC(raw)
+
+		return nil
+	default:
+		return fmt.Errorf("invalid format for reference-rpc at line %d: expected address or mapping", node.Line)
+	}
+}
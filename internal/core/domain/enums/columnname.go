@@ -4,28 +4,36 @@ type ColumnName string
 
 // Overview section.
 const (
-	ColumnNameIndex   ColumnName = "IDX"
-	ColumnNameHealth  ColumnName = "HEALTH"
-	ColumnNameAddress ColumnName = "ADDRESS"
-	ColumnNamePortRPC ColumnName = "RPC"
-	ColumnNameUptime  ColumnName = "UPTIME DAYS"
-	ColumnNameVersion ColumnName = "VERSION"
-	ColumnNameCommit  ColumnName = "COMMIT"
-	ColumnNameCountry ColumnName = "COUNTRY"
+	ColumnNameIndex          ColumnName = "IDX"
+	ColumnNameHealth         ColumnName = "HEALTH"
+	ColumnNameAddress        ColumnName = "ADDRESS"
+	ColumnNamePortRPC        ColumnName = "RPC"
+	ColumnNameUptime         ColumnName = "UPTIME DAYS"
+	ColumnNameVersion        ColumnName = "VERSION"
+	ColumnNameCommit         ColumnName = "COMMIT"
+	ColumnNameReleaseStatus  ColumnName = "RELEASE\nSTATUS"
+	ColumnNameReleasesBehind ColumnName = "RELEASES\nBEHIND"
+	ColumnNameBuildAge       ColumnName = "BUILD AGE\nDAYS"
+	ColumnNameCountry        ColumnName = "COUNTRY"
+	ColumnNameIPAddresses    ColumnName = "IP ADDRESSES"
+	ColumnNameLatency        ColumnName = "LATENCY\nLAST / AVG / P95"
 )
 
 // Transactions section.
 const (
-	ColumnNameTotalTransactionBlocks                  ColumnName = "TOTAL TX\nBLOCKS"
-	ColumnNameTotalTransactionCertificates            ColumnName = "TOTAL TX\nCERTIFICATES"
-	ColumnNameTotalTransactionEffects                 ColumnName = "TOTAL TX\nEFFECTS"
-	ColumnNameTXSyncPercentage                        ColumnName = "TX SYNC PCT"
-	ColumnNameSkippedConsensusTransactions            ColumnName = "SKIPPED\nCONSENSUS TX"
-	ColumnNameTotalTransactionCertificatesCreated     ColumnName = "TOTAL TX CERTIFICATES\nCREATED"
-	ColumnNameHandleCertificateNonConsensusLatencySum ColumnName = "CERTIFICATE\nNON CONSENSUS LATENCY"
-	ColumnNameTotalSignatureErrors                    ColumnName = "SIGNATURE\nERRORS"
-	ColumnNameTransactionsPerSecond                   ColumnName = "TRANSACTIONS PER SECOND"
-	ColumnNameCertificatesPerSecond                   ColumnName = "CERTIFICATES PER SECOND"
+	ColumnNameTotalTransactionBlocks              ColumnName = "TOTAL TX\nBLOCKS"
+	ColumnNameTotalTransactionCertificates        ColumnName = "TOTAL TX\nCERTIFICATES"
+	ColumnNameTotalTransactionEffects             ColumnName = "TOTAL TX\nEFFECTS"
+	ColumnNameTXSyncPercentage                    ColumnName = "TX SYNC PCT"
+	ColumnNameSkippedConsensusTransactions        ColumnName = "SKIPPED\nCONSENSUS TX"
+	ColumnNameTotalTransactionCertificatesCreated ColumnName = "TOTAL TX CERTIFICATES\nCREATED"
+	ColumnNameCertificateNonConsensusLatency      ColumnName = "CERT NON CONSENSUS LATENCY\nP50 / P95 / P99 / AVG, MS"
+	ColumnNameCertificateConsensusLatency         ColumnName = "CERT CONSENSUS LATENCY\nP50 / P95 / P99 / AVG, MS"
+	ColumnNameTotalSignatureErrors                ColumnName = "SIGNATURE\nERRORS"
+	ColumnNameSkippedConsensusTxPerMinute         ColumnName = "SKIPPED CONSENSUS TX\nPER MIN"
+	ColumnNameSignatureErrorsPerMinute            ColumnName = "SIGNATURE ERRORS\nPER MIN"
+	ColumnNameTransactionsPerSecond               ColumnName = "TRANSACTIONS PER SECOND"
+	ColumnNameCertificatesPerSecond               ColumnName = "CERTIFICATES PER SECOND"
 )
 
 // Checkpoints section.
@@ -141,51 +149,13 @@ const (
 
 // Protocol section.
 const (
-	ColumnNameMinSupportedProtocolVersion ColumnName = "MIN SUPPORTED\nPROTOCOL VERSION"
-	ColumnNameMaxSupportedProtocolVersion ColumnName = "MAX SUPPORTED\nPROTOCOL VERSION"
-	ColumnNameProtocolVersion             ColumnName = "PROTOCOL VERSION"
-)
-
-// nolint: dupl,nolintlint,gocritic // they are not duplicate
-const (
-	ColumnNameFeatureFlagAcceptZkloginInMultisig                  ColumnName = "ACCEPT ZKLOGIN\nIN MULTISIG"
-	ColumnNameFeatureFlagAdvanceEpochStartTimeInSafeMode          ColumnName = "ADVANCE EPOCH\nSTART TIME\nIN SAFE MODE"
-	ColumnNameFeatureFlagAdvanceToHighestSupportedProtocolVersion ColumnName = "ADVANCE TO\nHIGHEST SUPPORTED\nPROTOCOL VERSION"
-	ColumnNameFeatureFlagAllowReceivingObjectID                   ColumnName = "ALLOW RECEIVING\nOBJECT ID"
-	ColumnNameFeatureFlagBanEntryInit                             ColumnName = "BAN ENTRY INIT"
-	ColumnNameFeatureFlagCommitRootStateDigest                    ColumnName = "COMMIT ROOT\nSTATE DIGEST"
-	ColumnNameFeatureFlagConsensusOrderEndOfEpochLast             ColumnName = "CONSENSUS ORDER\nEND OF EPOCH LAST"
-	ColumnNameFeatureFlagDisableInvariantViolationCheckInSwapLoc  ColumnName = "DISABLE INVARIANT\nVIOLATION CHECK\nIN SWAP LOC"
-	ColumnNameFeatureFlagDisallowAddingAbilitiesOnUpgrade         ColumnName = "DISALLOW ADDING\nABILITIES\nON UPGRADE"
-	ColumnNameFeatureFlagDisallowChangeStructTypeParamsOnUpgrade  ColumnName = "DISALLOW CHANGE\nSTRUCT TYPE PARAMS\nON UPGRADE"
-	ColumnNameFeatureFlagEnableEffectsV2                          ColumnName = "ENABLE\nEFFECTS V2"
-	ColumnNameFeatureFlagEnableJwkConsensusUpdates                ColumnName = "ENABLE JWK\nCONSENSUS\nUPDATES"
-	ColumnNameFeatureFlagEndOfEpochTransactionSupported           ColumnName = "END OF EPOCH\nTRANSACTION\nSUPPORTED"
-	ColumnNameFeatureFlagHardenedOtwCheck                         ColumnName = "HARDENED\nOTW CHECK"
-	ColumnNameFeatureFlagIncludeConsensusDigestInPrologue         ColumnName = "INCLUDE\nCONSENSUS DIGEST\nIN PROLOGUE"
-	ColumnNameFeatureFlagLoadedChildObjectFormat                  ColumnName = "LOADED CHILD\nOBJECT FORMAT"
-	ColumnNameFeatureFlagLoadedChildObjectFormatType              ColumnName = "LOADED CHILD\nOBJECT FORMAT\nTYPE"
-	ColumnNameFeatureFlagLoadedChildObjectsFixed                  ColumnName = "LOADED CHILD\nOBJECTS FIXED"
-	ColumnNameFeatureFlagMissingTypeIsCompatibilityError          ColumnName = "MISSING TYPE\nIS COMPATIBILITY\nERROR"
-	ColumnNameFeatureFlagNarwhalCertificateV2                     ColumnName = "NARWHAL\nCERTIFICATE V2"
-	ColumnNameFeatureFlagNarwhalHeaderV2                          ColumnName = "NARWHAL\nHEADER V2"
-	ColumnNameFeatureFlagNarwhalNewLeaderElectionSchedule         ColumnName = "NARWHAL\nNEW LEADER\nELECTION SCHEDULE"
-	ColumnNameFeatureFlagNarwhalVersionedMetadata                 ColumnName = "NARWHAL\nVERSIONED METADATA"
-	ColumnNameFeatureFlagNoExtraneousModuleBytes                  ColumnName = "NO EXTRANEOUS\nMODULE BYTES"
-	ColumnNameFeatureFlagPackageDigestHashModule                  ColumnName = "PACKAGE\nDIGEST HASH\nMODULE"
-	ColumnNameFeatureFlagPackageUpgrades                          ColumnName = "PACKAGE\nUPGRADES"
-	ColumnNameFeatureFlagRandomBeacon                             ColumnName = "RANDOM\nBEACON"
-	ColumnNameFeatureFlagReceiveObjects                           ColumnName = "RECEIVE\nOBJECTS"
-	ColumnNameFeatureFlagRecomputeHasPublicTransferInExecution    ColumnName = "RECOMPUTE HAS\nPUBLIC TRANSFER\nIN EXECUTION"
-	ColumnNameFeatureFlagScoringDecisionWithValidityCutoff        ColumnName = "SCORING DECISION\nWITH VALIDITY\nCUTOFF"
-	ColumnNameFeatureFlagSharedObjectDeletion                     ColumnName = "SHARED OBJECT\nDELETION"
-	ColumnNameFeatureFlagSimpleConservationChecks                 ColumnName = "SIMPLE\nCONSERVATION\nCHECKS"
-	ColumnNameFeatureFlagSimplifiedUnwrapThenDelete               ColumnName = "SIMPLIFIED\nUNWRAP\nTHEN DELETE"
-	ColumnNameFeatureFlagThroughputAwareConsensusSubmission       ColumnName = "THROUGHPUT AWARE\nCONSENSUS\nSUBMISSION"
-	ColumnNameFeatureFlagTxnBaseCostAsMultiplier                  ColumnName = "TXN BASE COST\nAS MULTIPLIER"
-	ColumnNameFeatureFlagUpgradedMultisigSupported                ColumnName = "UPGRADED MULTISIG\nSUPPORTED"
-	ColumnNameFeatureFlagVerifyLegacyZkloginAddress               ColumnName = "VERIFY LEGACY ZK\nLOGIN ADDRESS"
-	ColumnNameFeatureFlagZkLoginAuth                              ColumnName = "ZK LOGIN AUTH"
+	ColumnNameProtocolEntryType          ColumnName = "TYPE"
+	ColumnNameProtocolEntryName          ColumnName = "NAME"
+	ColumnNameProtocolEntryValue         ColumnName = "VALUE"
+	ColumnNameProtocolEntryPreviousValue ColumnName = "PREVIOUS\nVERSION VALUE"
+	ColumnNameProtocolEntryChange        ColumnName = "CHANGE"
+	ColumnNameProtocolEntryFromValue     ColumnName = "FROM\nVERSION VALUE"
+	ColumnNameProtocolEntryToValue       ColumnName = "TO\nVERSION VALUE"
 )
 
 // Release section.
@@ -206,6 +176,73 @@ const (
 	ColumnNameNumberSharedObjectTransactions ColumnName = "NUMBER OF\nSHARED OBJ TX"
 )
 
+// Decentralization section.
+const (
+	ColumnNameLocationDimension       ColumnName = "GROUP BY"
+	ColumnNameLocationNakamoto        ColumnName = "NAKAMOTO\nCOEFFICIENT"
+	ColumnNameLocationGroup           ColumnName = "GROUP"
+	ColumnNameLocationValidators      ColumnName = "VALIDATORS"
+	ColumnNameLocationStake           ColumnName = "STAKE, SUI"
+	ColumnNameLocationVotingPower     ColumnName = "VOTING POWER"
+	ColumnNameLocationShare           ColumnName = "VOTING POWER\nSHARE, %"
+	ColumnNameLocationCumulativeShare ColumnName = "CUMULATIVE\nSHARE, %"
+)
+
+// Stake distribution section.
+const (
+	ColumnNameStakeMetric       ColumnName = "METRIC"
+	ColumnNameStakeCurrentEpoch ColumnName = "CURRENT EPOCH"
+	ColumnNameStakeNextEpoch    ColumnName = "NEXT EPOCH"
+	ColumnNameStakeChange       ColumnName = "CHANGE"
+)
+
+// Validator set section.
+const (
+	ColumnNameValidatorSetChange    ColumnName = "CHANGE"
+	ColumnNameValidatorSetName      ColumnName = "VALIDATOR NAME"
+	ColumnNameValidatorSetAddress   ColumnName = "VALIDATOR ADDRESS"
+	ColumnNameValidatorSetStake     ColumnName = "STAKE, SUI"
+	ColumnNameValidatorSetNextStake ColumnName = "NEXT EPOCH\nSTAKE, SUI"
+)
+
+// Subsidy forecast section.
+const (
+	ColumnNameForecastEpochs          ColumnName = "EPOCHS"
+	ColumnNameForecastStart           ColumnName = "ESTIMATED\nSTART TIME UTC"
+	ColumnNameForecastDistribution    ColumnName = "DISTRIBUTION\nPER EPOCH, SUI"
+	ColumnNameForecastDistributed     ColumnName = "DISTRIBUTED\nIN PERIOD, SUI"
+	ColumnNameForecastBalance         ColumnName = "SUBSIDY BALANCE\nAFTER PERIOD, SUI"
+	ColumnNameForecastRewardsPerEpoch ColumnName = "STAKE REWARDS\nPER EPOCH, SUI"
+	ColumnNameForecastRewardsApy      ColumnName = "STAKE REWARDS\nAPY, %"
+)
+
+// At risk projection section.
+const (
+	ColumnNameAtRiskProjection      ColumnName = "PROJECTION"
+	ColumnNameAtRiskName            ColumnName = "VALIDATOR NAME"
+	ColumnNameAtRiskAddress         ColumnName = "VALIDATOR ADDRESS"
+	ColumnNameAtRiskNextStake       ColumnName = "NEXT EPOCH\nSTAKE, SUI"
+	ColumnNameAtRiskShortfall       ColumnName = "SHORTFALL TO\nLOW THRESHOLD, SUI"
+	ColumnNameAtRiskEpochs          ColumnName = "EPOCHS AT RISK\nNEXT EPOCH"
+	ColumnNameAtRiskGraceEpochsLeft ColumnName = "GRACE EPOCHS\nLEFT"
+)
+
+// Next epoch changes section.
+const (
+	ColumnNameNextEpochValidatorName        ColumnName = "VALIDATOR NAME"
+	ColumnNameNextEpochGasPrice             ColumnName = "GAS PRICE"
+	ColumnNameNextEpochNextGasPrice         ColumnName = "NEXT EPOCH\nGAS PRICE"
+	ColumnNameNextEpochGasPriceDelta        ColumnName = "GAS PRICE\nCHANGE"
+	ColumnNameNextEpochCommissionRate       ColumnName = "COMMISSION\nRATE, %"
+	ColumnNameNextEpochNextCommissionRate   ColumnName = "NEXT EPOCH\nCOMMISSION RATE, %"
+	ColumnNameNextEpochCommissionRateDelta  ColumnName = "COMMISSION RATE\nCHANGE, %"
+	ColumnNameNextEpochStake                ColumnName = "STAKE, SUI"
+	ColumnNameNextEpochNextStake            ColumnName = "NEXT EPOCH\nSTAKE, SUI"
+	ColumnNameNextEpochStakeDelta           ColumnName = "STAKE\nCHANGE, SUI"
+	ColumnNameNextEpochStakeDeltaPercentage ColumnName = "STAKE\nCHANGE, %"
+	ColumnNameNextEpochRotations            ColumnName = "PENDING\nROTATIONS"
+)
+
 func (e ColumnName) ToString() string {
 	return string(e)
 }
@@ -0,0 +1,30 @@
+package enums
+
+import (
+	"fmt"
+	"strings"
+)
+
+type GeoProvider string
+
+const (
+	GeoProviderIPInfo GeoProvider = "ipinfo"
+	GeoProviderMMDB   GeoProvider = "mmdb"
+)
+
+// ParseGeoProvider converts the provider name used in the ip-lookup config section into a GeoProvider.
+// An empty name defaults to ipinfo.
+func ParseGeoProvider(name string) (GeoProvider, error) {
+	switch provider := GeoProvider(strings.ToLower(strings.TrimSpace(name))); provider {
+	case "":
+		return GeoProviderIPInfo, nil
+	case GeoProviderIPInfo, GeoProviderMMDB:
+		return provider, nil
+	default:
+		return "", fmt.Errorf("unsupported ip lookup provider: %s", name)
+	}
+}
+
+func (e GeoProvider) ToString() string {
+	return string(e)
+}
@@ -31,12 +31,16 @@ const (
 	MetricTypeSkippedConsensusTransactions         MetricType = "SKIPPED_CONSENSUS_TRANSACTIONS"
 	MetricTypeTotalSignatureErrors                 MetricType = "TOTAL_SIGNATURE_ERRORS"
 	MetricTypeTotalTransactionCertificatesCreated  MetricType = "TOTAL_TRANSACTION_CERTIFICATES_CREATED"
-	MetricTypeNonConsensusLatencySum               MetricType = "NON_CONSENSUS_LATENCY_SUM"
+	MetricTypeCertificateNonConsensusLatency       MetricType = "CERTIFICATE_NON_CONSENSUS_LATENCY"
+	MetricTypeCertificateConsensusLatency          MetricType = "CERTIFICATE_CONSENSUS_LATENCY"
 	MetricTypeProtocol                             MetricType = "PROTOCOL"
 	MetricTypeCurrentVotingRight                   MetricType = "CURRENT_VOTING_RIGHT"
 	MetricTypeConsensusLastCommittedLeaderRound    MetricType = "CONSENSUS_LAST_COMMITTED_LEADER_ROUND"
 	MetricTypeConsensusHighestAcceptedRound        MetricType = "CONSENSUS_HIGHEST_ACCEPTED_ROUND"
 	MetricTypeNumberSharedObjectTransactions       MetricType = "NUMBER_OF_SHARED_OBJECT_TRANSACTIONS"
+	MetricTypeRoundsPerSecond                      MetricType = "ROUNDS_PER_SECOND"
+	MetricTypeCertificatesPerSecond                MetricType = "CERTIFICATES_PER_SECOND"
+	MetricTypeLatency                              MetricType = "LATENCY"
 )
 
 func (e MetricType) ToString() string {
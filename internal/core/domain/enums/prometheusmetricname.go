@@ -15,7 +15,8 @@ const (
 	PrometheusMetricNameSkippedConsensusTransactions         PrometheusMetricName = "skipped_consensus_txns"
 	PrometheusMetricNameTotalSignatureErrors                 PrometheusMetricName = "total_signature_errors"
 	PrometheusMetricNameTotalTransactionCertificatesCreated  PrometheusMetricName = "total_tx_certificates_created"
-	PrometheusMetricNameNonConsensusLatencySum               PrometheusMetricName = "validator_service_handle_certificate_non_consensus_latency_sum"
+	PrometheusMetricNameCertificateNonConsensusLatency       PrometheusMetricName = "validator_service_handle_certificate_non_consensus_latency"
+	PrometheusMetricNameCertificateConsensusLatency          PrometheusMetricName = "validator_service_handle_certificate_consensus_latency"
 	PrometheusMetricNameUptime                               PrometheusMetricName = "uptime"
 	PrometheusMetricNameCurrentVotingRight                   PrometheusMetricName = "current_voting_right"
 	PrometheusMetricNameNumberSharedObjectTransactions       PrometheusMetricName = "num_shared_obj_tx"
@@ -23,6 +24,10 @@ const (
 	PrometheusMetricNameConsensusCommittedMessages           PrometheusMetricName = "consensus_committed_messages"
 	PrometheusMetricNameConsensusProposedBlocks              PrometheusMetricName = "consensus_proposed_blocks"
 	PrometheusMetricNameConsensusHighestAcceptedRound        PrometheusMetricName = "consensus_highest_accepted_round"
+
+	// The Narwhal consensus metrics exposed by the sui-node releases before Mysticeti.
+	PrometheusMetricNameNarwhalLastCommittedRound PrometheusMetricName = "last_committed_round"
+	PrometheusMetricNameNarwhalCurrentRound       PrometheusMetricName = "current_round"
 )
 
 func (e PrometheusMetricName) ToString() string {
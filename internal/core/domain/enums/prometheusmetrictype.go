@@ -1,5 +1,10 @@
 package enums
 
+import (
+	"fmt"
+	"strings"
+)
+
 type PrometheusMetricType int
 
 const (
@@ -9,3 +14,27 @@ const (
 	PrometheusMetricTypeSummary
 	PrometheusMetricTypeUntyped
 )
+
+// ParsePrometheusMetricType converts the metric type name used in the config file into a PrometheusMetricType.
+// An empty name defaults to the gauge.
+func ParsePrometheusMetricType(name string) (PrometheusMetricType, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "gauge":
+		return PrometheusMetricTypeGauge, nil
+	case "counter":
+		return PrometheusMetricTypeCounter, nil
+	case "histogram":
+		return PrometheusMetricTypeHistogram, nil
+	case "summary":
+		return PrometheusMetricTypeSummary, nil
+	case "untyped":
+		return PrometheusMetricTypeUntyped, nil
+	default:
+		return 0, fmt.Errorf("unsupported metric type: %s", name)
+	}
+}
+
+// IsDistribution reports whether the metric type carries a distribution of observations.
+func (e PrometheusMetricType) IsDistribution() bool {
+	return e == PrometheusMetricTypeHistogram || e == PrometheusMetricTypeSummary
+}
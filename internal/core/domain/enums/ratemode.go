@@ -0,0 +1,30 @@
+package enums
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RateMode string
+
+const (
+	RateModeWindow RateMode = "window"
+	RateModeEWMA   RateMode = "ewma"
+)
+
+// ParseRateMode converts the rate mode name used in the config file into a RateMode.
+// An empty name defaults to the sliding window.
+func ParseRateMode(name string) (RateMode, error) {
+	switch mode := RateMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case "":
+		return RateModeWindow, nil
+	case RateModeWindow, RateModeEWMA:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported rate mode: %s", name)
+	}
+}
+
+func (e RateMode) ToString() string {
+	return string(e)
+}
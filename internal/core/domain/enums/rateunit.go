@@ -0,0 +1,41 @@
+package enums
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RateUnit string
+
+const (
+	RateUnitNone   RateUnit = ""
+	RateUnitSecond RateUnit = "second"
+	RateUnitMinute RateUnit = "minute"
+)
+
+// ParseRateUnit converts the rate unit name used in the config file into a RateUnit.
+// An empty name means that no rate is computed.
+func ParseRateUnit(name string) (RateUnit, error) {
+	switch unit := RateUnit(strings.ToLower(strings.TrimSpace(name))); unit {
+	case RateUnitNone, RateUnitSecond, RateUnitMinute:
+		return unit, nil
+	default:
+		return "", fmt.Errorf("unsupported rate unit: %s", name)
+	}
+}
+
+// Label returns the suffix used in the column names for the rate unit.
+func (e RateUnit) Label() string {
+	switch e {
+	case RateUnitSecond:
+		return "PER SEC"
+	case RateUnitMinute:
+		return "PER MIN"
+	default:
+		return ""
+	}
+}
+
+func (e RateUnit) ToString() string {
+	return string(e)
+}
@@ -8,6 +8,8 @@ const (
 	RPCMethodGetLatestCheckpointSequenceNumber RPCMethod = "sui_getLatestCheckpointSequenceNumber"
 	RPCMethodGetValidatorsApy                  RPCMethod = "suix_getValidatorsApy"
 	RPCMethodGetProtocol                       RPCMethod = "sui_getProtocolConfig"
+	RPCMethodGetDynamicFields                  RPCMethod = "suix_getDynamicFields"
+	RPCMethodGetObject                         RPCMethod = "sui_getObject"
 )
 
 func (e RPCMethod) String() string {
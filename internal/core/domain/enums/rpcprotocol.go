@@ -0,0 +1,31 @@
+package enums
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RPCProtocol string
+
+const (
+	RPCProtocolJSONRPC RPCProtocol = "jsonrpc"
+	RPCProtocolGraphQL RPCProtocol = "graphql"
+	RPCProtocolGRPC    RPCProtocol = "grpc"
+)
+
+// ParseRPCProtocol converts the protocol name used in the config file into an RPCProtocol.
+// An empty name defaults to JSON-RPC.
+func ParseRPCProtocol(name string) (RPCProtocol, error) {
+	switch protocol := RPCProtocol(strings.ToLower(strings.TrimSpace(name))); protocol {
+	case "":
+		return RPCProtocolJSONRPC, nil
+	case RPCProtocolJSONRPC, RPCProtocolGraphQL, RPCProtocolGRPC:
+		return protocol, nil
+	default:
+		return "", fmt.Errorf("unsupported rpc protocol: %s", name)
+	}
+}
+
+func (e RPCProtocol) ToString() string {
+	return string(e)
+}
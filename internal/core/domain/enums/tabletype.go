@@ -10,9 +10,16 @@ const (
 	TableTypeProtocol           TableType = "🌐 PROTOCOL"
 	TableTypeValidatorParams    TableType = "📊 VALIDATOR PARAMS"
 	TableTypeValidatorsAtRisk   TableType = "🚨 VALIDATORS AT RISK"
+	TableTypeAtRiskProjection   TableType = "📉 AT RISK PROJECTION"
 	TableTypeValidatorReports   TableType = "📢 VALIDATOR REPORTS"
 	TableTypeActiveValidators   TableType = "✅ ACTIVE VALIDATORS"
+	TableTypeNextEpochChanges   TableType = "🔜 NEXT EPOCH CHANGES"
 	TableTypeReleases           TableType = "📈 RELEASE HISTORY"
+	TableTypeDecentralization   TableType = "🌍 DECENTRALIZATION"
+	TableTypeStakeDistribution  TableType = "🏦 STAKE DISTRIBUTION"
+	TableTypeProtocolDiff       TableType = "🔀 PROTOCOL DIFF"
+	TableTypeValidatorSet       TableType = "🔄 VALIDATOR SET"
+	TableTypeSubsidyForecast    TableType = "🔮 SUBSIDY FORECAST"
 )
 
 func (e TableType) ToString() string {
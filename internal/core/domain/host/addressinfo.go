@@ -22,6 +22,8 @@ const (
 type AddressInfo struct {
 	Ports    map[enums.PortType]string
 	Endpoint address.Endpoint
+	Protocol enums.RPCProtocol
+	Instance string
 }
 
 // GetUrlRPC generates a URL for the RPC endpoint of the address.
@@ -86,6 +88,26 @@ func (addr *AddressInfo) GetURLPrometheus() (string, error) {
 	return hostURL.String(), nil
 }
 
+// GetInstance returns the value of the instance label the host metrics are stored with on the Prometheus server.
+// If the instance is not configured, the host and port of the metrics endpoint are used, as Prometheus does by default.
+func (addr *AddressInfo) GetInstance() (string, error) {
+	if addr.Instance != "" {
+		return addr.Instance, nil
+	}
+
+	metricsURL, err := addr.GetURLPrometheus()
+	if err != nil {
+		return "", err
+	}
+
+	parsedURL, err := url.Parse(metricsURL)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedURL.Host, nil
+}
+
 // getProtocol returns the protocol based on the secure flag.
 func getProtocol(secure bool) string {
 	protocol := protocolHTTP
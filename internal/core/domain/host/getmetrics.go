@@ -1,13 +1,17 @@
 package host
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
 	"github.com/bartosian/suimon/internal/core/ports"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -37,7 +41,8 @@ var (
 		enums.PrometheusMetricNameSkippedConsensusTransactions:         enums.MetricTypeSkippedConsensusTransactions,
 		enums.PrometheusMetricNameTotalSignatureErrors:                 enums.MetricTypeTotalSignatureErrors,
 		enums.PrometheusMetricNameUptime:                               enums.MetricTypeUptime,
-		enums.PrometheusMetricNameNonConsensusLatencySum:               enums.MetricTypeNonConsensusLatencySum,
+		enums.PrometheusMetricNameCertificateNonConsensusLatency:       enums.MetricTypeCertificateNonConsensusLatency,
+		enums.PrometheusMetricNameCertificateConsensusLatency:          enums.MetricTypeCertificateConsensusLatency,
 		enums.PrometheusMetricNameCurrentVotingRight:                   enums.MetricTypeCurrentVotingRight,
 		enums.PrometheusMetricNameTotalTransactionCertificatesCreated:  enums.MetricTypeTotalTransactionCertificatesCreated,
 		enums.PrometheusMetricNameConsensusLastCommittedLeaderRound:    enums.MetricTypeConsensusLastCommittedLeaderRound,
@@ -103,19 +108,55 @@ func getPrometheusMetricsForTableType(table enums.TableType) ports.Metrics {
 		metrics[enums.PrometheusMetricNameConsensusHighestAcceptedRound] = newMetricConfig(enums.PrometheusMetricTypeGauge)
 		metrics[enums.PrometheusMetricNameConsensusRoundProberCurrentRoundGaps] = newMetricConfig(enums.PrometheusMetricTypeGauge)
 		metrics[enums.PrometheusMetricNameNumberSharedObjectTransactions] = newMetricConfig(enums.PrometheusMetricTypeCounter)
+		metrics[enums.PrometheusMetricNameCertificateNonConsensusLatency] = newMetricConfig(enums.PrometheusMetricTypeHistogram)
+		metrics[enums.PrometheusMetricNameCertificateConsensusLatency] = newMetricConfig(enums.PrometheusMetricTypeHistogram)
 	}
 
 	return metrics
 }
 
+// ValidateCustomMetrics checks that the custom metrics requested for the table type
+// are unique and do not overlap with the metrics suimon requests itself.
+func ValidateCustomMetrics(table enums.TableType, customMetrics metrics.CustomMetrics) error {
+	requested := getPrometheusMetricsForTableType(table)
+	columns := make(map[enums.ColumnName]struct{}, len(customMetrics))
+
+	for _, customMetric := range customMetrics {
+		if _, ok := requested[customMetric.Name]; ok {
+			return fmt.Errorf("custom metric %s is already requested", customMetric.Name)
+		}
+
+		if _, ok := columns[customMetric.Column]; ok {
+			return fmt.Errorf("custom metric column %q is defined more than once", customMetric.Column)
+		}
+
+		requested[customMetric.Name] = newMetricConfig(customMetric.Type)
+		columns[customMetric.Column] = struct{}{}
+	}
+
+	return nil
+}
+
 // GetPrometheusMetrics retrieves the Prometheus metrics for the host
 // and processes them accordingly.
 // It calls Prometheus for metrics, processes the result, and sets the values in the host's Metrics.
-// Returns an error if there is an issue calling Prometheus or processing the metrics.
-func (host *Host) GetPrometheusMetrics() error {
+// Returns an error if there is an issue calling Prometheus, none of the metrics is found or processing the metrics fails.
+func (host *Host) GetPrometheusMetrics(ctx context.Context) error {
 	metricsDef := getPrometheusMetricsForTableType(host.TableType)
 
-	result, err := host.gateways.prometheus.CallFor(metricsDef)
+	for metricName, metricConfig := range metricsDef {
+		metricConfig.Aliases = getMetricAliases(metricName, host.Metrics.Version)
+		metricsDef[metricName] = metricConfig
+	}
+
+	for _, customMetric := range host.Metrics.CustomMetrics {
+		metricConfig := newMetricConfig(customMetric.Type, customMetric.Labels)
+		metricConfig.Aliases = customMetric.Aliases
+
+		metricsDef[customMetric.Name] = metricConfig
+	}
+
+	result, err := host.gateways.prometheus.CallFor(ctx, metricsDef)
 	if err != nil {
 		return fmt.Errorf("error calling Prometheus for metrics: %w", err)
 	}
@@ -124,16 +165,81 @@ func (host *Host) GetPrometheusMetrics() error {
 		return errors.New("failed to get metrics from Prometheus")
 	}
 
+	if err := checkMetricsFound(result); err != nil {
+		return err
+	}
+
+	if err := host.processCustomMetrics(result); err != nil {
+		return err
+	}
+
 	return host.processPrometheusMetrics(result)
 }
 
+// checkMetricsFound returns an error if none of the requested metrics was found,
+// e.g. because the metrics address does not point to a sui-node.
+func checkMetricsFound(result ports.MetricsResult) error {
+	var firstErr error
+
+	for _, metricValue := range result {
+		if metricValue.Err == nil {
+			return nil
+		}
+
+		if firstErr == nil {
+			firstErr = metricValue.Err
+		}
+	}
+
+	return fmt.Errorf("no requested metrics found: %w", firstErr)
+}
+
+// processCustomMetrics sets the values of the custom metrics from the Prometheus metrics result, or their errors if they were not found,
+// and removes them from the result.
+func (host *Host) processCustomMetrics(result ports.MetricsResult) error {
+	now := time.Now()
+
+	for _, customMetric := range host.Metrics.CustomMetrics {
+		metricValue, ok := result[customMetric.Name]
+		if !ok {
+			continue
+		}
+
+		delete(result, customMetric.Name)
+
+		if metricValue.Err != nil {
+			host.Metrics.SetCustomError(customMetric.Name, metricValue.Err)
+			continue
+		}
+
+		var value any = metricValue.Value
+		if metricValue.Histogram != nil {
+			value = *metricValue.Histogram
+		}
+
+		if err := host.Metrics.SetCustomValue(customMetric.Name, value, now); err != nil {
+			return fmt.Errorf("error setting custom metric %s: %w", customMetric.Name, err)
+		}
+
+		if metricValue.Rate != nil {
+			host.Metrics.SetCustomRate(customMetric.Name, *metricValue.Rate)
+		}
+	}
+
+	return nil
+}
+
 // processPrometheusMetrics processes the Prometheus metrics result and sets the values in the host's Metrics.
 // It iterates through the result map, sets the metric values, and handles specific cases for certain metric types.
+// The errors of the metrics that were not found are recorded in the host's Metrics instead of being returned.
 // Returns an error if there is an issue setting the metric values or handling specific cases.
 // The function also updates the version and commit metrics if the metric type is uptime.
 // Parameters:
 // - result: The Prometheus metrics result to be processed.
 func (host *Host) processPrometheusMetrics(result ports.MetricsResult) error {
+	metricErrors := make(metrics.MetricErrors)
+	defer host.Metrics.SetMetricErrors(metricErrors)
+
 	for metricName, metricValue := range result {
 		metricType, ok := prometheusToMetric[metricName]
 		if !ok {
@@ -141,10 +247,28 @@ func (host *Host) processPrometheusMetrics(result ports.MetricsResult) error {
 			continue
 		}
 
-		if err := host.Metrics.SetValue(metricType, metricValue.Value); err != nil {
+		// The missing metrics are shown as not available instead of failing the host.
+		if metricValue.Err != nil {
+			metricErrors[metricType] = metricValue.Err
+
+			delete(result, metricName)
+
+			continue
+		}
+
+		var value any = metricValue.Value
+		if metricValue.Histogram != nil {
+			value = *metricValue.Histogram
+		}
+
+		if err := host.Metrics.SetValue(metricType, value); err != nil {
 			return fmt.Errorf("error setting metric %s: %w", metricType, err)
 		}
 
+		if metricValue.Rate != nil {
+			host.Metrics.SetRate(metricType, *metricValue.Rate)
+		}
+
 		// Delete processed metric from result map
 		delete(result, metricName)
 
@@ -170,26 +294,48 @@ func (host *Host) processPrometheusMetrics(result ports.MetricsResult) error {
 
 // GetMetrics fetches data from the host by calling three different methods asynchronously: GetTotalTransactionNumber, GetLatestCheckpoint, and GetPrometheusMetrics.
 // The function waits for all three methods to complete before returning.
+// The calls are canceled only when the provided context is done, so a failing call does not abort the others.
+// The metrics are marked as updated once any of the calls succeeded, after all of them returned, as they set the metrics concurrently.
 // Returns an error if any of the three methods fail or return an error.
-func (host *Host) GetMetrics() error {
+func (host *Host) GetMetrics(ctx context.Context) error {
 	var errGroup errgroup.Group
 
+	var updated atomic.Bool
+
 	rpcMethods := tableToRPCMethods[host.TableType]
 	for _, method := range rpcMethods {
 		method := method
 
 		errGroup.Go(func() error {
-			return host.GetDataByMetric(method)
+			if err := host.GetDataByMetric(ctx, method); err != nil {
+				return err
+			}
+
+			updated.Store(true)
+
+			return nil
 		})
 	}
 
 	if ok := tablesToCallMetrics[host.TableType]; ok {
 		errGroup.Go(func() error {
-			return host.GetPrometheusMetrics()
+			if err := host.GetPrometheusMetrics(ctx); err != nil {
+				return err
+			}
+
+			updated.Store(true)
+
+			return nil
 		})
 	}
 
-	if err := errGroup.Wait(); err != nil {
+	err := errGroup.Wait()
+
+	if updated.Load() {
+		host.Metrics.Updated = true
+	}
+
+	if err != nil {
 		return fmt.Errorf("failed to get metrics for table %s, host: %s: %w", host.TableType, host.Endpoint.Address, err)
 	}
 
@@ -199,16 +345,26 @@ func (host *Host) GetMetrics() error {
 // GetDataByMetric is a method of the Host struct that retrieves data for a given RPC method
 // and stores it as a metric in the Metrics struct. It takes an RPCMethod input parameter and
 // returns an error if the method is not supported.
-func (host *Host) GetDataByMetric(method enums.RPCMethod) error {
+func (host *Host) GetDataByMetric(ctx context.Context, method enums.RPCMethod) error {
 	metric, ok := rpcMethodToMetric[method]
 	if !ok {
 		return fmt.Errorf("unsupported RPC method: %v", method)
 	}
 
-	result, err := host.gateways.rpc.CallFor(method)
+	result, err := host.gateways.rpc.CallFor(ctx, method)
 	if err != nil {
 		return err
 	}
 
 	return host.Metrics.SetValue(metric, result)
 }
+
+// GetProtocolConfig requests the protocol config of the given version from the host.
+func (host *Host) GetProtocolConfig(ctx context.Context, version string) (*metrics.Protocol, error) {
+	result, err := host.gateways.rpc.CallFor(ctx, enums.RPCMethodGetProtocol, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protocol config version %s: %w", version, err)
+	}
+
+	return metrics.ParseProtocol(result)
+}
@@ -0,0 +1,139 @@
+package host
+
+import (
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+var (
+	// tableToHistoryMetrics maps a table type to the metrics recorded in the history for its hosts.
+	tableToHistoryMetrics = map[enums.TableType][]enums.MetricType{
+		enums.TableTypeRPC: {
+			enums.MetricTypeTotalTransactionBlocks,
+			enums.MetricTypeTransactionsPerSecond,
+			enums.MetricTypeLatestCheckpoint,
+			enums.MetricTypeTxSyncPercentage,
+			enums.MetricTypeCheckSyncPercentage,
+			enums.MetricTypeLatency,
+		},
+		enums.TableTypeNode: {
+			enums.MetricTypeTotalTransactionBlocks,
+			enums.MetricTypeTransactionsPerSecond,
+			enums.MetricTypeLatestCheckpoint,
+			enums.MetricTypeHighestSyncedCheckpoint,
+			enums.MetricTypeCheckpointsPerSecond,
+			enums.MetricTypeTxSyncPercentage,
+			enums.MetricTypeCheckSyncPercentage,
+			enums.MetricTypeCheckpointExecBacklog,
+			enums.MetricTypeCheckpointSyncBacklog,
+			enums.MetricTypeSuiNetworkPeers,
+			enums.MetricTypeCurrentEpoch,
+			enums.MetricTypeLatency,
+		},
+		enums.TableTypeValidator: {
+			enums.MetricTypeHighestSyncedCheckpoint,
+			enums.MetricTypeCheckpointsPerSecond,
+			enums.MetricTypeCheckpointExecBacklog,
+			enums.MetricTypeCheckpointSyncBacklog,
+			enums.MetricTypeSuiNetworkPeers,
+			enums.MetricTypeCurrentEpoch,
+			enums.MetricTypeRoundsPerSecond,
+			enums.MetricTypeCertificatesPerSecond,
+			enums.MetricTypeLatency,
+		},
+	}
+
+	// historyMetricSources maps the derived metrics to the metrics they are computed from, which decide their availability.
+	historyMetricSources = map[enums.MetricType][]enums.MetricType{
+		enums.MetricTypeTransactionsPerSecond: {enums.MetricTypeTotalTransactionBlocks},
+		enums.MetricTypeCheckpointsPerSecond:  {enums.MetricTypeHighestSyncedCheckpoint},
+		enums.MetricTypeRoundsPerSecond:       {enums.MetricTypeConsensusHighestAcceptedRound},
+		enums.MetricTypeCertificatesPerSecond: {enums.MetricTypeTotalTransactionCertificatesCreated},
+		enums.MetricTypeCheckpointExecBacklog: {enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeLastExecutedCheckpoint},
+		enums.MetricTypeCheckpointSyncBacklog: {enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeHighestSyncedCheckpoint},
+		enums.MetricTypeTxSyncPercentage:      {enums.MetricTypeTotalTransactionBlocks},
+		enums.MetricTypeCheckSyncPercentage:   {enums.MetricTypeLatestCheckpoint},
+		enums.MetricTypeLatency:               {},
+	}
+)
+
+// GetHistoryKey returns the key the samples of the host are recorded under in the history of the network.
+func (host *Host) GetHistoryKey(network string) ports.HistoryKey {
+	return ports.HistoryKey{
+		Network: network,
+		Table:   host.TableType,
+		Address: host.Endpoint.Address,
+	}
+}
+
+// GetHistorySample returns the status of the host and the values of its key metrics to be recorded in the history.
+// The metrics missing on the last update are left out, and no values are set if the host has not been updated at all.
+func (host *Host) GetHistorySample(now time.Time) ports.HistorySample {
+	sample := ports.HistorySample{
+		Time:   now,
+		Status: host.Status,
+		Values: make(map[enums.MetricType]float64),
+	}
+
+	if !host.Metrics.Updated {
+		return sample
+	}
+
+	for _, metricType := range tableToHistoryMetrics[host.TableType] {
+		sources, ok := historyMetricSources[metricType]
+		if !ok {
+			sources = []enums.MetricType{metricType}
+		}
+
+		if !host.Metrics.IsAvailable(sources...) {
+			continue
+		}
+
+		if value, ok := host.getHistoryValue(metricType); ok {
+			sample.Values[metricType] = value
+		}
+	}
+
+	return sample
+}
+
+// getHistoryValue returns the value of the metric recorded in the history, or false if the metric is not recorded.
+func (host *Host) getHistoryValue(metricType enums.MetricType) (float64, bool) {
+	metrics := &host.Metrics
+
+	//nolint: exhaustive // only the metrics recorded in the history are handled
+	switch metricType {
+	case enums.MetricTypeTotalTransactionBlocks:
+		return float64(metrics.TotalTransactionsBlocks), true
+	case enums.MetricTypeTransactionsPerSecond:
+		return float64(metrics.TransactionsPerSecond), true
+	case enums.MetricTypeLatestCheckpoint:
+		return float64(metrics.LatestCheckpoint), true
+	case enums.MetricTypeHighestSyncedCheckpoint:
+		return float64(metrics.HighestSyncedCheckpoint), true
+	case enums.MetricTypeCheckpointsPerSecond:
+		return float64(metrics.CheckpointsPerSecond), true
+	case enums.MetricTypeTxSyncPercentage:
+		return float64(metrics.TxSyncPercentage), true
+	case enums.MetricTypeCheckSyncPercentage:
+		return float64(metrics.CheckSyncPercentage), true
+	case enums.MetricTypeCheckpointExecBacklog:
+		return float64(metrics.CheckpointExecBacklog), true
+	case enums.MetricTypeCheckpointSyncBacklog:
+		return float64(metrics.CheckpointSyncBacklog), true
+	case enums.MetricTypeSuiNetworkPeers:
+		return float64(metrics.NetworkPeers), true
+	case enums.MetricTypeCurrentEpoch:
+		return float64(metrics.CurrentEpoch), true
+	case enums.MetricTypeRoundsPerSecond:
+		return float64(metrics.RoundsPerSecond), true
+	case enums.MetricTypeCertificatesPerSecond:
+		return float64(metrics.CertificatesPerSecond), true
+	case enums.MetricTypeLatency:
+		return float64(host.GetLatencyMs()), true
+	}
+
+	return 0, false
+}
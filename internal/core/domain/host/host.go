@@ -2,6 +2,7 @@ package host
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/dariubs/percent"
 
@@ -23,8 +24,10 @@ type Gateways struct {
 type Host struct {
 	AddressInfo
 
-	gateways Gateways
-	IPInfo   *ports.IPResult
+	gateways    Gateways
+	ResolvedIPs []net.IP
+	ResolveErr  error
+	IPInfo      *ports.IPResult
 
 	TableType enums.TableType
 
@@ -49,6 +52,9 @@ func NewHost(
 			prometheus: prometheusGW,
 			cli:        cliGW,
 		},
+		Metrics: metrics.Metrics{
+			Rates: metrics.NewRates(metrics.RateConfig{}),
+		},
 	}
 
 	return host
@@ -100,7 +106,7 @@ func (host *Host) SetStatus(rpc *Host) {
 
 		if metricsHost.TotalTransactionsBlocks == 0 ||
 			metricsHost.LatestCheckpoint == 0 ||
-			(metricsHost.TransactionsPerSecond == 0 && len(metricsHost.TransactionsHistory) == metrics.TransactionsPerSecondWindow) ||
+			(metricsHost.TransactionsPerSecond == 0 && metricsHost.Rate(enums.MetricTypeTotalTransactionBlocks).Ready()) ||
 			metricsHost.TxSyncPercentage == 0 ||
 			metricsHost.TxSyncPercentage > 110 ||
 			metricsHost.CheckSyncPercentage > 110 {
@@ -0,0 +1,56 @@
+package host
+
+import (
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/pkg/latency"
+)
+
+// GetLatency returns the round-trip time statistics recorded by the gateway serving the given endpoint type.
+// It returns zero values if the host has no gateway for the endpoint or no calls were made yet.
+func (host *Host) GetLatency(portType enums.PortType) latency.Stats {
+	switch portType {
+	case enums.PortTypeRPC:
+		if host.gateways.rpc != nil {
+			return host.gateways.rpc.Latency()
+		}
+	case enums.PortTypeMetrics:
+		if host.gateways.prometheus != nil {
+			return host.gateways.prometheus.Latency()
+		}
+	}
+
+	return latency.Stats{}
+}
+
+// GetLatencyDisplay returns the latency statistics of the endpoints used by the host table type formatted for tables.
+// Each endpoint is rendered on a separate line as "<ENDPOINT> last / avg / p95 ms".
+func (host *Host) GetLatencyDisplay() string {
+	var lines []string
+
+	if _, ok := tableToRPCMethods[host.TableType]; ok {
+		if stats := host.GetLatency(enums.PortTypeRPC); !stats.IsEmpty() {
+			lines = append(lines, "RPC "+stats.String())
+		}
+	}
+
+	if ok := tablesToCallMetrics[host.TableType]; ok {
+		if stats := host.GetLatency(enums.PortTypeMetrics); !stats.IsEmpty() {
+			lines = append(lines, "METRICS "+stats.String())
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// GetLatencyMs returns the last round-trip time in milliseconds of the primary endpoint for the host table type.
+// The RPC endpoint is used when the host is polled over RPC, otherwise the metrics endpoint is used.
+func (host *Host) GetLatencyMs() int {
+	portType := enums.PortTypeMetrics
+	if _, ok := tableToRPCMethods[host.TableType]; ok {
+		portType = enums.PortTypeRPC
+	}
+
+	return int(host.GetLatency(portType).Last.Milliseconds())
+}
@@ -0,0 +1,66 @@
+package host
+
+import (
+	"sort"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/pkg/version"
+)
+
+// metricAlias is a name a metric is exposed with by the sui-node releases starting from the given version.
+type metricAlias struct {
+	name  enums.PrometheusMetricName
+	since version.Version
+}
+
+// mysticetiVersion is the first sui-node release running the Mysticeti consensus instead of Narwhal.
+var mysticetiVersion = version.MustParse("1.29.0")
+
+// metricAliases lists the names of the metrics renamed across the sui-node releases.
+var metricAliases = map[enums.PrometheusMetricName][]metricAlias{
+	enums.PrometheusMetricNameConsensusLastCommittedLeaderRound: {
+		{name: enums.PrometheusMetricNameConsensusLastCommittedLeaderRound, since: mysticetiVersion},
+		{name: enums.PrometheusMetricNameNarwhalLastCommittedRound},
+	},
+	enums.PrometheusMetricNameConsensusHighestAcceptedRound: {
+		{name: enums.PrometheusMetricNameConsensusHighestAcceptedRound, since: mysticetiVersion},
+		{name: enums.PrometheusMetricNameNarwhalCurrentRound},
+	},
+}
+
+// getMetricAliases returns the names the metric is looked up by for the given sui-node version in order of preference.
+// The names used by the version come first, the newest first, followed by the names of the later releases.
+// The newest names are tried first if the version is not known yet. It returns nil if the metric was never renamed.
+func getMetricAliases(metric enums.PrometheusMetricName, nodeVersion string) []enums.PrometheusMetricName {
+	aliases, ok := metricAliases[metric]
+	if !ok {
+		return nil
+	}
+
+	current, err := version.Parse(nodeVersion)
+	isKnown := err == nil
+
+	ordered := append([]metricAlias(nil), aliases...)
+
+	sort.SliceStable(ordered, func(left, right int) bool {
+		leftUsed := !isKnown || ordered[left].since.Compare(current) <= 0
+		rightUsed := !isKnown || ordered[right].since.Compare(current) <= 0
+
+		if leftUsed != rightUsed {
+			return leftUsed
+		}
+
+		if leftUsed {
+			return ordered[left].since.Compare(ordered[right].since) > 0
+		}
+
+		return ordered[left].since.Compare(ordered[right].since) < 0
+	})
+
+	names := make([]enums.PrometheusMetricName, 0, len(ordered))
+	for _, alias := range ordered {
+		names = append(names, alias.name)
+	}
+
+	return names
+}
@@ -0,0 +1,56 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/pkg/address"
+)
+
+// dnsResolutionFailed is shown instead of the IP addresses of the hosts the hostname of which could not be resolved.
+const dnsResolutionFailed = "DNS RESOLUTION FAILED"
+
+// ResolveIPs sets the ResolvedIPs property of a Host struct with the IP addresses of the host.
+// Hosts configured by IP address are resolved to that address, while hostnames are looked up in DNS and all A and AAAA records are kept.
+// It returns an error wrapping address.ErrDNSResolution if the lookup fails or returns no records, which is kept in ResolveErr.
+func (host *Host) ResolveIPs(ctx context.Context) error {
+	ips, err := host.Endpoint.ResolveIPs(ctx)
+
+	host.ResolveErr = err
+
+	if err != nil {
+		return err
+	}
+
+	host.ResolvedIPs = ips
+
+	return nil
+}
+
+// HasDNSError reports whether the hostname of the host could not be resolved on the last ResolveIPs call.
+func (host *Host) HasDNSError() bool {
+	return errors.Is(host.ResolveErr, address.ErrDNSResolution)
+}
+
+// GetPublicIP returns the first public IP address the host resolved to, which is used for the geolocation.
+// It returns nil if the host has not been resolved or all of its addresses are private, loopback or link-local.
+func (host *Host) GetPublicIP() net.IP {
+	return address.FirstPublicIP(host.ResolvedIPs)
+}
+
+// GetResolvedIPsDisplay returns the IP addresses the host resolved to, one per line, to be shown in the tables.
+// Hosts the hostname of which could not be resolved are marked with dnsResolutionFailed.
+func (host *Host) GetResolvedIPsDisplay() string {
+	if host.HasDNSError() {
+		return dnsResolutionFailed
+	}
+
+	ips := make([]string, 0, len(host.ResolvedIPs))
+	for _, ip := range host.ResolvedIPs {
+		ips = append(ips, ip.String())
+	}
+
+	return strings.Join(ips, "\n")
+}
@@ -1,27 +1,23 @@
 package host
 
 import (
-	"fmt"
-	"net"
+	"context"
 )
 
 const (
 	ErrInvalidIPAddressProvided = "invalid IP address: %v"
 )
 
-// SetIPInfo sets the IPInfo property of a Host struct by calling an external geolocation API with the host's IP address.
-// It returns an error if the IP address is invalid or if the API call fails.
-func (host *Host) SetIPInfo() error {
-	if host.Endpoint.IP == nil {
-		return nil
-	}
-
-	ip := net.ParseIP(*host.Endpoint.IP)
+// SetIPInfo sets the IPInfo property of a Host struct by calling an external geolocation API with the first public IP address the host resolved to.
+// Hosts without a public IP address are skipped, so ResolveIPs has to be called first.
+// It returns an error if the API call fails.
+func (host *Host) SetIPInfo(ctx context.Context) error {
+	ip := host.GetPublicIP()
 	if ip == nil {
-		return fmt.Errorf(ErrInvalidIPAddressProvided, host.Endpoint.IP)
+		return nil
 	}
 
-	ipInfo, err := host.gateways.geo.CallFor(ip)
+	ipInfo, err := host.gateways.geo.CallFor(ctx, ip)
 	if err != nil {
 		return err
 	}
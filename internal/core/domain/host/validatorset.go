@@ -0,0 +1,123 @@
+package host
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const (
+	// dynamicFieldsPageSize is the number of the dynamic fields requested per page.
+	dynamicFieldsPageSize = 50
+
+	// maxDynamicFieldsPages limits the number of the pages requested for a table of validators.
+	maxDynamicFieldsPages = 20
+)
+
+// GetValidatorSet requests the pending active validators and the validator candidates from the host,
+// resolving the entries of their tables with the IDs reported in the system state, and returns the validator set.
+func (host *Host) GetValidatorSet(ctx context.Context) (*metrics.ValidatorSet, error) {
+	systemState := &host.Metrics.SystemState
+
+	pendingActive, err := host.getValidatorsTable(ctx, systemState.PendingActiveValidatorsID, systemState.PendingActiveValidatorsSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending active validators: %w", err)
+	}
+
+	candidates, err := host.getValidatorsTable(ctx, systemState.ValidatorCandidatesID, systemState.ValidatorCandidatesSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator candidates: %w", err)
+	}
+
+	return metrics.NewValidatorSet(systemState, pendingActive, candidates)
+}
+
+// getValidatorsTable requests the validators stored in the table with the ID as its dynamic fields.
+// The table is not requested if it is empty.
+func (host *Host) getValidatorsTable(ctx context.Context, tableID, size string) ([]metrics.ValidatorSetMember, error) {
+	if tableID == "" || size == "" || size == "0" {
+		return nil, nil
+	}
+
+	fieldIDs, err := host.getDynamicFieldIDs(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]metrics.ValidatorSetMember, 0, len(fieldIDs))
+
+	for _, fieldID := range fieldIDs {
+		validator, err := host.getValidator(ctx, fieldID)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, validator)
+	}
+
+	return validators, nil
+}
+
+// getValidator requests the dynamic field object storing a validator. The versioned validators are stored
+// as a dynamic field of the versioned object, which is requested in turn.
+func (host *Host) getValidator(ctx context.Context, fieldID string) (metrics.ValidatorSetMember, error) {
+	result, err := host.gateways.rpc.CallFor(ctx, enums.RPCMethodGetObject, fieldID, map[string]bool{"showContent": true})
+	if err != nil {
+		return metrics.ValidatorSetMember{}, err
+	}
+
+	value, err := metrics.ParseDynamicFieldValue(result)
+	if err != nil {
+		return metrics.ValidatorSetMember{}, err
+	}
+
+	validator, versionedID, ok, err := metrics.ParseValidatorSetMember(value)
+	if err != nil || ok {
+		return validator, err
+	}
+
+	versionIDs, err := host.getDynamicFieldIDs(ctx, versionedID)
+	if err != nil {
+		return metrics.ValidatorSetMember{}, err
+	}
+
+	if len(versionIDs) == 0 {
+		return metrics.ValidatorSetMember{}, fmt.Errorf("no validator stored in versioned object %s", versionedID)
+	}
+
+	return host.getValidator(ctx, versionIDs[0])
+}
+
+// getDynamicFieldIDs requests the IDs of the dynamic field objects of the parent object, page by page.
+func (host *Host) getDynamicFieldIDs(ctx context.Context, parentID string) ([]string, error) {
+	var (
+		ids    []string
+		cursor any
+	)
+
+	for page := 0; page < maxDynamicFieldsPages; page++ {
+		result, err := host.gateways.rpc.CallFor(ctx, enums.RPCMethodGetDynamicFields, parentID, cursor, dynamicFieldsPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldsPage, err := metrics.ParseDynamicFieldsPage(result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range fieldsPage.Data {
+			ids = append(ids, field.ObjectID)
+		}
+
+		if !fieldsPage.HasNextPage || fieldsPage.NextCursor == nil {
+			break
+		}
+
+		cursor = fieldsPage.NextCursor
+	}
+
+	return ids, nil
+}
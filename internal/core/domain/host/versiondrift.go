@@ -0,0 +1,18 @@
+package host
+
+import (
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// SetVersionDrift compares the version and commit run by the host with the releases of the network.
+// A healthy host running a commit that is not in the releases is marked yellow.
+func (host *Host) SetVersionDrift(releases []metrics.Release, now time.Time) {
+	host.Metrics.VersionDrift = metrics.GetVersionDrift(releases, host.Metrics.Version, host.Metrics.Commit, now)
+
+	if host.Metrics.VersionDrift.UnreleasedCommit && host.Status == enums.StatusGreen {
+		host.Status = enums.StatusYellow
+	}
+}
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// AtRiskProjection is the change of the at-risk status of a validator projected for the end of the epoch.
+type AtRiskProjection string
+
+const (
+	AtRiskProjectionRemovedVeryLowStake AtRiskProjection = "REMOVED, VERY LOW STAKE"
+	AtRiskProjectionRemovedGraceOver    AtRiskProjection = "REMOVED, GRACE PERIOD OVER"
+	AtRiskProjectionEnters              AtRiskProjection = "ENTERS AT RISK"
+	AtRiskProjectionRemains             AtRiskProjection = "REMAINS AT RISK"
+	AtRiskProjectionLeaves              AtRiskProjection = "LEAVES AT RISK"
+)
+
+// ValidatorAtRiskProjection represents the at-risk status of a validator projected for the end of the epoch
+// from its next epoch stake. The shortfall is the stake missing to reach the low stake threshold, in SUI.
+type ValidatorAtRiskProjection struct {
+	Name            string
+	Address         string
+	Projection      AtRiskProjection
+	NextEpochStake  string
+	ShortfallSui    int64
+	EpochsAtRisk    int64
+	GraceEpochsLeft int64
+}
+
+// GetAtRiskProjections projects the at-risk status of the active validators for the end of the epoch, applying the rules
+// of the network to their next epoch stake: a validator under the very low stake threshold is removed immediately,
+// one under the low stake threshold is at risk for one more epoch and is removed once the epochs at risk exceed
+// the grace period, and one at risk reaching the low stake threshold leaves the at-risk list.
+// Only the validators the at-risk status of which changes or remains are returned, the removed ones first.
+func (systemState *SuiSystemState) GetAtRiskProjections() ([]ValidatorAtRiskProjection, error) {
+	thresholds, err := parseBigInts(systemState.ValidatorLowStakeThreshold, systemState.ValidatorVeryLowStakeThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	lowThreshold, veryLowThreshold := thresholds[0], thresholds[1]
+
+	gracePeriod, err := strconv.ParseInt(systemState.ValidatorLowStakeGracePeriod, base10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected metric value type: %s", systemState.ValidatorLowStakeGracePeriod)
+	}
+
+	epochsAtRisk := make(map[string]int64, len(systemState.ValidatorsAtRiskParsed))
+
+	for _, validator := range systemState.ValidatorsAtRiskParsed {
+		epochs, err := strconv.ParseInt(validator.EpochsAtRisk, base10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected metric value type: %s", validator.EpochsAtRisk)
+		}
+
+		epochsAtRisk[validator.Address] = epochs
+	}
+
+	projections := make([]ValidatorAtRiskProjection, 0)
+
+	for _, validator := range systemState.ActiveValidators {
+		nextEpochStake, ok := new(big.Int).SetString(validator.NextEpochStake, base10)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric value type for NextEpochStake: %s", validator.NextEpochStake)
+		}
+
+		epochs, atRisk := epochsAtRisk[validator.SuiAddress]
+
+		projection := ValidatorAtRiskProjection{
+			Name:           validator.Name,
+			Address:        validator.SuiAddress,
+			NextEpochStake: validator.NextEpochStake,
+			EpochsAtRisk:   epochs,
+		}
+
+		switch {
+		case nextEpochStake.Cmp(veryLowThreshold) < 0:
+			projection.Projection = AtRiskProjectionRemovedVeryLowStake
+		case nextEpochStake.Cmp(lowThreshold) < 0:
+			projection.EpochsAtRisk = epochs + 1
+			projection.GraceEpochsLeft = gracePeriod - projection.EpochsAtRisk
+
+			switch {
+			case projection.GraceEpochsLeft < 0:
+				projection.Projection, projection.GraceEpochsLeft = AtRiskProjectionRemovedGraceOver, 0
+			case atRisk:
+				projection.Projection = AtRiskProjectionRemains
+			default:
+				projection.Projection = AtRiskProjectionEnters
+			}
+		case atRisk:
+			projection.Projection, projection.EpochsAtRisk = AtRiskProjectionLeaves, 0
+		default:
+			continue
+		}
+
+		if nextEpochStake.Cmp(lowThreshold) < 0 {
+			shortfall := new(big.Int).Sub(lowThreshold, nextEpochStake)
+			projection.ShortfallSui = shortfall.Div(shortfall, big.NewInt(suiRate)).Int64()
+		}
+
+		projections = append(projections, projection)
+	}
+
+	sort.SliceStable(projections, func(left, right int) bool {
+		leftOrder, rightOrder := atRiskProjectionOrder[projections[left].Projection], atRiskProjectionOrder[projections[right].Projection]
+		if leftOrder != rightOrder {
+			return leftOrder < rightOrder
+		}
+
+		return projections[left].GraceEpochsLeft < projections[right].GraceEpochsLeft
+	})
+
+	return projections, nil
+}
+
+// atRiskProjectionOrder orders the projections by their severity.
+var atRiskProjectionOrder = map[AtRiskProjection]int{
+	AtRiskProjectionRemovedVeryLowStake: 0,
+	AtRiskProjectionRemovedGraceOver:    1,
+	AtRiskProjectionRemains:             2,
+	AtRiskProjectionEnters:              3,
+	AtRiskProjectionLeaves:              4,
+}
+
+// parseBigInts parses the integers, returning an error for the first one that cannot be parsed.
+func parseBigInts(values ...string) ([]*big.Int, error) {
+	result := make([]*big.Int, 0, len(values))
+
+	for _, value := range values {
+		parsed, ok := new(big.Int).SetString(value, base10)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric value type: %s", value)
+		}
+
+		result = append(result, parsed)
+	}
+
+	return result, nil
+}
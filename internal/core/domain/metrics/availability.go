@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// NotAvailable is shown instead of the values of the metrics the host does not expose.
+const NotAvailable = "n/a"
+
+// MetricErrors keeps the errors of the metrics that could not be collected, e.g. because they were renamed or dropped by the sui-node release.
+type MetricErrors map[enums.MetricType]error
+
+// SetMetricErrors replaces the errors of the metrics collected from the host on the last update.
+// The map is replaced as a whole and never modified afterwards, so it can be read while the next update is in progress.
+func (metrics *Metrics) SetMetricErrors(metricErrors MetricErrors) {
+	metrics.MetricErrors = metricErrors
+}
+
+// MetricError returns the error of the metric collected on the last update or nil if the metric was collected.
+func (metrics *Metrics) MetricError(metric enums.MetricType) error {
+	return metrics.MetricErrors[metric]
+}
+
+// IsAvailable reports whether all the given metrics were collected on the last update.
+func (metrics *Metrics) IsAvailable(metricTypes ...enums.MetricType) bool {
+	for _, metric := range metricTypes {
+		if metrics.MetricErrors[metric] != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValueOrNotAvailable returns the value if all the metrics it is derived from are available, and NotAvailable otherwise.
+func (metrics *Metrics) ValueOrNotAvailable(value any, metricTypes ...enums.MetricType) any {
+	if !metrics.IsAvailable(metricTypes...) {
+		return NotAvailable
+	}
+
+	return value
+}
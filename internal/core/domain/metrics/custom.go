@@ -0,0 +1,201 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/pkg/histogram"
+)
+
+const (
+	unitMilliseconds        = "MS"
+	millisecondsInSecond    = 1000
+	customValuePrecision    = 2
+	customValueScaleDefault = 1
+)
+
+type (
+	// CustomMetric represents a metric defined in the custom-metrics section of the config file together with its latest value.
+	// The metric is shown in the column named after its display name, unit and rate unit.
+	// Aliases lists the names the metric is looked up by in order if alternative names are configured.
+	CustomMetric struct {
+		Name      enums.PrometheusMetricName
+		Aliases   []enums.PrometheusMetricName
+		Labels    map[string]string
+		Column    enums.ColumnName
+		Title     string
+		Unit      string
+		RateUnit  enums.RateUnit
+		Type      enums.PrometheusMetricType
+		Dashboard bool
+
+		value     float64
+		rate      *Rate
+		histogram histogram.Window
+		err       error
+		updated   bool
+	}
+
+	// CustomMetrics represents the custom metrics requested for a host.
+	CustomMetrics []CustomMetric
+)
+
+// NewCustomMetric creates a custom metric, building the column name and the dashboard cell title
+// from the display name, the unit and the rate unit. The metric name is used if no display name is provided.
+// The aliases are the alternative names tried in order if the metric is not exposed under its name.
+func NewCustomMetric(
+	name enums.PrometheusMetricName,
+	aliases []enums.PrometheusMetricName,
+	labels map[string]string,
+	metricType enums.PrometheusMetricType,
+	displayName, unit string,
+	rateUnit enums.RateUnit,
+	dashboard bool,
+) CustomMetric {
+	if displayName == "" {
+		displayName = name.ToString()
+	}
+
+	displayName = strings.ToUpper(strings.TrimSpace(displayName))
+	unit = strings.ToUpper(strings.TrimSpace(unit))
+
+	var suffixes []string
+	if unit != "" {
+		suffixes = append(suffixes, unit)
+	}
+
+	if rateUnit != enums.RateUnitNone {
+		suffixes = append(suffixes, rateUnit.Label())
+	}
+
+	// The metric is looked up by its name first, followed by the aliases.
+	var names []enums.PrometheusMetricName
+	if len(aliases) > 0 {
+		names = append([]enums.PrometheusMetricName{name}, aliases...)
+	}
+
+	column, title := displayName, displayName
+	if len(suffixes) > 0 {
+		column += "\n" + strings.Join(suffixes, " ")
+		title += ", " + strings.Join(suffixes, " ")
+	}
+
+	return CustomMetric{
+		Name:      name,
+		Aliases:   names,
+		Labels:    labels,
+		Column:    enums.ColumnName(column),
+		Title:     title,
+		Unit:      unit,
+		RateUnit:  rateUnit,
+		Type:      metricType,
+		Dashboard: dashboard,
+	}
+}
+
+// SetCustomValue updates the custom metric with the value observed at the given time.
+// The value is expected to be a float64 or a histogram.Snapshot for histograms and summaries.
+func (metrics *Metrics) SetCustomValue(name enums.PrometheusMetricName, value any, at time.Time) error {
+	metric := metrics.getCustomMetric(name)
+	if metric == nil {
+		return fmt.Errorf("unknown custom metric: %s", name)
+	}
+
+	switch v := value.(type) {
+	case histogram.Snapshot:
+		metric.histogram.Update(v)
+	case float64:
+		metric.value = v
+
+		if metric.RateUnit != enums.RateUnitNone {
+			metric.getRate(metrics.RateConfig).Add(v, at)
+		}
+	default:
+		return fmt.Errorf(ErrUnexpectedMetricValueType, name, value)
+	}
+
+	metric.err = nil
+	metric.updated = true
+
+	return nil
+}
+
+// SetCustomError records the error of the custom metric that could not be collected on the last update.
+// The metric is shown as not available until its value is collected again.
+func (metrics *Metrics) SetCustomError(name enums.PrometheusMetricName, err error) {
+	if metric := metrics.getCustomMetric(name); metric != nil {
+		metric.err = err
+	}
+}
+
+// SetCustomRate sets the per-second rate of the custom metric computed elsewhere, e.g. by the Prometheus server.
+// It is ignored if no rate is requested for the metric.
+func (metrics *Metrics) SetCustomRate(name enums.PrometheusMetricName, perSecond float64) {
+	metric := metrics.getCustomMetric(name)
+	if metric == nil || metric.RateUnit == enums.RateUnitNone {
+		return
+	}
+
+	metric.getRate(metrics.RateConfig).Set(perSecond)
+}
+
+// getCustomMetric returns the custom metric with the given name or nil if it is not requested.
+func (metrics *Metrics) getCustomMetric(name enums.PrometheusMetricName) *CustomMetric {
+	for idx := range metrics.CustomMetrics {
+		if metrics.CustomMetrics[idx].Name == name {
+			return &metrics.CustomMetrics[idx]
+		}
+	}
+
+	return nil
+}
+
+// FormatValue returns the value of the custom metric formatted for the tables and dashboards.
+// The distributions are formatted as "p50 / p95 / p99 / avg". Their observations are converted from seconds
+// to milliseconds if the unit is ms, as sui-node exposes the latencies in seconds.
+// It returns NotAvailable if the metric could not be collected on the last update and an empty string if the value was not collected yet.
+func (metric *CustomMetric) FormatValue() string {
+	if metric.err != nil {
+		return NotAvailable
+	}
+
+	if !metric.updated {
+		return ""
+	}
+
+	if metric.Type.IsDistribution() {
+		scale := float64(customValueScaleDefault)
+		if metric.Unit == unitMilliseconds {
+			scale = millisecondsInSecond
+		}
+
+		return metric.histogram.Stats().Scaled(scale)
+	}
+
+	if metric.rate != nil {
+		if metric.RateUnit == enums.RateUnitMinute {
+			return metric.rate.FormatPerMinute()
+		}
+
+		return metric.rate.FormatPerSecond()
+	}
+
+	if metric.value == math.Trunc(metric.value) {
+		return strconv.FormatFloat(metric.value, 'f', 0, 64)
+	}
+
+	return strconv.FormatFloat(metric.value, 'f', customValuePrecision, 64)
+}
+
+// getRate returns the rate of the custom metric, creating it with the given config on the first use.
+func (metric *CustomMetric) getRate(config RateConfig) *Rate {
+	if metric.rate == nil {
+		metric.rate = NewRate(config)
+	}
+
+	return metric.rate
+}
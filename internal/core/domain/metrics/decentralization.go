@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+const (
+	// LocationUnknown is the group of the validators that could not be geolocated.
+	LocationUnknown = "UNKNOWN"
+
+	// nakamotoThreshold is the share of the total voting power needed to halt the network.
+	nakamotoThreshold = 1.0 / 3
+	// nakamotoThresholdTwoThirds is the share of the total voting power needed to take over the network.
+	nakamotoThresholdTwoThirds = 2.0 / 3
+	percentage100              = 100
+)
+
+// LocationDimension is the property of the validator location the validators are grouped by.
+type LocationDimension string
+
+const (
+	LocationDimensionCountry  LocationDimension = "COUNTRY"
+	LocationDimensionASN      LocationDimension = "ASN"
+	LocationDimensionProvider LocationDimension = "PROVIDER"
+)
+
+var locationDimensions = []LocationDimension{
+	LocationDimensionCountry,
+	LocationDimensionASN,
+	LocationDimensionProvider,
+}
+
+type (
+	// ValidatorLocation represents where the validator node is hosted.
+	ValidatorLocation struct {
+		IP       string
+		Country  string
+		ASN      string
+		Provider string
+	}
+
+	// ValidatorsLocations maps the validator addresses to their locations.
+	ValidatorsLocations map[string]ValidatorLocation
+
+	// LocationGroup represents the validators hosted in the same country, ASN or provider.
+	LocationGroup struct {
+		Name            string
+		Validators      int
+		Stake           *big.Int
+		VotingPower     int64
+		Share           float64
+		CumulativeShare float64
+	}
+
+	// LocationDistribution represents the distribution of the voting power across the groups of one dimension.
+	// The Nakamoto coefficient is the minimum number of groups controlling more than a third of the voting power.
+	LocationDistribution struct {
+		Dimension LocationDimension
+		Groups    []LocationGroup
+		Nakamoto  int
+	}
+)
+
+// GetValue returns the value of the location for the dimension, or LocationUnknown if it is not set.
+func (location ValidatorLocation) GetValue(dimension LocationDimension) string {
+	var value string
+
+	switch dimension {
+	case LocationDimensionCountry:
+		value = location.Country
+	case LocationDimensionASN:
+		value = location.ASN
+	case LocationDimensionProvider:
+		value = location.Provider
+	}
+
+	if value == "" {
+		return LocationUnknown
+	}
+
+	return value
+}
+
+// GetLocationDistributions groups the validators by country, ASN and provider of their locations and
+// aggregates the stake and the voting power of each group. The groups are sorted by voting power, highest first.
+// The validators without a location are grouped as LocationUnknown, which is not counted in the Nakamoto coefficient.
+func (validators Validators) GetLocationDistributions(locations ValidatorsLocations) ([]LocationDistribution, error) {
+	var totalVotingPower int64
+
+	votingPowers := make([]int64, len(validators))
+	stakes := make([]*big.Int, len(validators))
+
+	for idx, validator := range validators {
+		votingPower, err := strconv.ParseInt(validator.VotingPower, base10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected metric value type for VotingPower: %s", validator.VotingPower)
+		}
+
+		stake, ok := new(big.Int).SetString(validator.StakingPoolSuiBalance, base10)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric value type for StakingPoolSuiBalance: %s", validator.StakingPoolSuiBalance)
+		}
+
+		votingPowers[idx] = votingPower
+		stakes[idx] = stake.Div(stake, big.NewInt(suiRate))
+		totalVotingPower += votingPower
+	}
+
+	distributions := make([]LocationDistribution, 0, len(locationDimensions))
+
+	for _, dimension := range locationDimensions {
+		groupsByName := make(map[string]*LocationGroup)
+
+		for idx, validator := range validators {
+			name := locations[validator.SuiAddress].GetValue(dimension)
+
+			group, ok := groupsByName[name]
+			if !ok {
+				group = &LocationGroup{Name: name, Stake: new(big.Int)}
+				groupsByName[name] = group
+			}
+
+			group.Validators++
+			group.VotingPower += votingPowers[idx]
+			group.Stake.Add(group.Stake, stakes[idx])
+		}
+
+		groups := make([]LocationGroup, 0, len(groupsByName))
+		for _, group := range groupsByName {
+			groups = append(groups, *group)
+		}
+
+		sort.Slice(groups, func(left, right int) bool {
+			if groups[left].VotingPower != groups[right].VotingPower {
+				return groups[left].VotingPower > groups[right].VotingPower
+			}
+
+			return groups[left].Name < groups[right].Name
+		})
+
+		distribution := LocationDistribution{Dimension: dimension, Groups: groups}
+
+		var cumulativeShare, nakamotoShare float64
+
+		for idx := range groups {
+			if totalVotingPower > 0 {
+				groups[idx].Share = float64(groups[idx].VotingPower) / float64(totalVotingPower)
+			}
+
+			cumulativeShare += groups[idx].Share
+			groups[idx].CumulativeShare = cumulativeShare
+
+			if groups[idx].Name == LocationUnknown || nakamotoShare > nakamotoThreshold {
+				continue
+			}
+
+			nakamotoShare += groups[idx].Share
+			distribution.Nakamoto++
+		}
+
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions, nil
+}
+
+// FormatShare returns the share as a percentage with two decimals.
+func FormatShare(share float64) string {
+	return strconv.FormatFloat(share*percentage100, 'f', 2, 64)
+}
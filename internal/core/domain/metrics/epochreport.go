@@ -0,0 +1,367 @@
+package metrics
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const epochReportTimeLayout = "2006-01-02 15:04:05 MST"
+
+type (
+	// EpochReport summarizes the changes of the Sui system state between the last state seen in an epoch
+	// and the first state seen in the next one. The amounts are in SUI.
+	EpochReport struct {
+		Network                    string                 `json:"network"`
+		Epoch                      string                 `json:"epoch"`
+		NextEpoch                  string                 `json:"nextEpoch"`
+		GeneratedAt                time.Time              `json:"generatedAt"`
+		Joined                     []EpochValidator       `json:"joined"`
+		Left                       []EpochValidator       `json:"left"`
+		PendingRemovals            []EpochValidator       `json:"pendingRemovals"`
+		StakeChanges               []EpochStakeChange     `json:"stakeChanges"`
+		ReferenceGasPrice          EpochValueChange       `json:"referenceGasPrice"`
+		StakeSubsidyDistributedSui int64                  `json:"stakeSubsidyDistributedSui"`
+		StorageFundSui             EpochValueChange       `json:"storageFundSui"`
+		ValidatorsAtRisk           []EpochValidatorAtRisk `json:"validatorsAtRisk"`
+		ValidatorReports           []EpochValidatorReport `json:"validatorReports"`
+	}
+
+	// EpochValidator identifies a validator in the epoch report.
+	EpochValidator struct {
+		Name    string `json:"name"`
+		Address string `json:"address"`
+	}
+
+	// EpochStakeChange is the change of the staking pool balance of a validator active in both epochs.
+	EpochStakeChange struct {
+		EpochValidator
+		PreviousStakeSui int64 `json:"previousStakeSui"`
+		StakeSui         int64 `json:"stakeSui"`
+		DeltaSui         int64 `json:"deltaSui"`
+	}
+
+	// EpochValueChange is the value at the end of the epoch and at the start of the next one.
+	EpochValueChange struct {
+		Previous int64 `json:"previous"`
+		Current  int64 `json:"current"`
+		Delta    int64 `json:"delta"`
+	}
+
+	// EpochValidatorAtRisk is a validator below the low stake threshold at the start of the next epoch.
+	EpochValidatorAtRisk struct {
+		EpochValidator
+		EpochsAtRisk string `json:"epochsAtRisk"`
+	}
+
+	// EpochValidatorReport is a validator reported by the other validators during the epoch.
+	EpochValidatorReport struct {
+		Name               string   `json:"name"`
+		SlashingPercentage float64  `json:"slashingPercentage"`
+		Reporters          []string `json:"reporters"`
+	}
+)
+
+// NewEpochReport creates the report of the epoch ended between the previous and the current system states.
+// The validator reports are taken from the previous state, as they are cleared when the epoch changes,
+// and the validators at risk and the pending removals from the current one.
+func NewEpochReport(network string, previous, current *SuiSystemState, now time.Time) (*EpochReport, error) {
+	report := &EpochReport{
+		Network:          network,
+		Epoch:            previous.Epoch,
+		NextEpoch:        current.Epoch,
+		GeneratedAt:      now,
+		Joined:           make([]EpochValidator, 0),
+		Left:             make([]EpochValidator, 0),
+		StakeChanges:     make([]EpochStakeChange, 0),
+		ValidatorsAtRisk: make([]EpochValidatorAtRisk, 0),
+		ValidatorReports: make([]EpochValidatorReport, 0),
+	}
+
+	previousValidators := getValidatorsByAddress(previous.ActiveValidators)
+	currentValidators := getValidatorsByAddress(current.ActiveValidators)
+
+	for _, validator := range current.ActiveValidators {
+		if _, ok := previousValidators[validator.SuiAddress]; !ok {
+			report.Joined = append(report.Joined, newEpochValidator(validator))
+		}
+	}
+
+	for _, validator := range previous.ActiveValidators {
+		currentValidator, ok := currentValidators[validator.SuiAddress]
+		if !ok {
+			report.Left = append(report.Left, newEpochValidator(validator))
+
+			continue
+		}
+
+		change, err := newEpochStakeChange(validator, currentValidator)
+		if err != nil {
+			return nil, err
+		}
+
+		if change.DeltaSui != 0 {
+			report.StakeChanges = append(report.StakeChanges, change)
+		}
+	}
+
+	sort.SliceStable(report.StakeChanges, func(left, right int) bool {
+		return absInt64(report.StakeChanges[left].DeltaSui) > absInt64(report.StakeChanges[right].DeltaSui)
+	})
+
+	pendingRemovals, err := current.getPendingRemovals()
+	if err != nil {
+		return nil, err
+	}
+
+	report.PendingRemovals = pendingRemovals
+
+	if report.ReferenceGasPrice, err = newEpochValueChange(previous.ReferenceGasPrice, current.ReferenceGasPrice, false); err != nil {
+		return nil, err
+	}
+
+	subsidy, err := newEpochValueChange(previous.StakeSubsidyBalance, current.StakeSubsidyBalance, true)
+	if err != nil {
+		return nil, err
+	}
+
+	report.StakeSubsidyDistributedSui = -subsidy.Delta
+
+	previousStorageFund, err := sumMist(previous.StorageFundTotalObjectStorageRebates, previous.StorageFundNonRefundableBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	currentStorageFund, err := sumMist(current.StorageFundTotalObjectStorageRebates, current.StorageFundNonRefundableBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.StorageFundSui, err = newEpochValueChange(previousStorageFund, currentStorageFund, true); err != nil {
+		return nil, err
+	}
+
+	for _, validator := range current.ValidatorsAtRiskParsed {
+		report.ValidatorsAtRisk = append(report.ValidatorsAtRisk, EpochValidatorAtRisk{
+			EpochValidator: EpochValidator{Name: validator.Name, Address: validator.Address},
+			EpochsAtRisk:   validator.EpochsAtRisk,
+		})
+	}
+
+	for _, validatorReport := range previous.ValidatorReportsParsed {
+		reporters := make([]string, 0, len(validatorReport.Reporters))
+		for _, reporter := range validatorReport.Reporters {
+			reporters = append(reporters, reporter.Name)
+		}
+
+		report.ValidatorReports = append(report.ValidatorReports, EpochValidatorReport{
+			Name:               validatorReport.Name,
+			SlashingPercentage: validatorReport.SlashingPercentage,
+			Reporters:          reporters,
+		})
+	}
+
+	return report, nil
+}
+
+// Markdown renders the epoch report as a Markdown document.
+func (report *EpochReport) Markdown() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "# %s epoch %s report\n\n", strings.ToUpper(report.Network), report.Epoch)
+	fmt.Fprintf(&builder, "Epoch %s ended, epoch %s started. Generated at %s.\n\n", report.Epoch, report.NextEpoch, report.GeneratedAt.UTC().Format(epochReportTimeLayout))
+
+	builder.WriteString("## Summary\n\n")
+	builder.WriteString("| Metric | Previous | Current | Change |\n|---|---:|---:|---:|\n")
+	fmt.Fprintf(&builder, "| Reference gas price, MIST | %d | %d | %+d |\n",
+		report.ReferenceGasPrice.Previous, report.ReferenceGasPrice.Current, report.ReferenceGasPrice.Delta)
+	fmt.Fprintf(&builder, "| Storage fund, SUI | %d | %d | %+d |\n",
+		report.StorageFundSui.Previous, report.StorageFundSui.Current, report.StorageFundSui.Delta)
+	fmt.Fprintf(&builder, "| Stake subsidy distributed, SUI | | | %d |\n\n", report.StakeSubsidyDistributedSui)
+
+	writeEpochValidators(&builder, "Joined validators", report.Joined)
+	writeEpochValidators(&builder, "Left validators", report.Left)
+	writeEpochValidators(&builder, "Pending removals", report.PendingRemovals)
+
+	builder.WriteString("## Stake changes\n\n")
+
+	if len(report.StakeChanges) == 0 {
+		builder.WriteString("None.\n\n")
+	} else {
+		builder.WriteString("| Validator | Previous stake, SUI | Stake, SUI | Change, SUI |\n|---|---:|---:|---:|\n")
+
+		for _, change := range report.StakeChanges {
+			fmt.Fprintf(&builder, "| %s | %d | %d | %+d |\n", change.Name, change.PreviousStakeSui, change.StakeSui, change.DeltaSui)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## Validators at risk\n\n")
+
+	if len(report.ValidatorsAtRisk) == 0 {
+		builder.WriteString("None.\n\n")
+	} else {
+		builder.WriteString("| Validator | Address | Epochs at risk |\n|---|---|---:|\n")
+
+		for _, validator := range report.ValidatorsAtRisk {
+			fmt.Fprintf(&builder, "| %s | `%s` | %s |\n", validator.Name, validator.Address, validator.EpochsAtRisk)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("## Reported validators\n\n")
+
+	if len(report.ValidatorReports) == 0 {
+		builder.WriteString("None.\n")
+	} else {
+		builder.WriteString("| Validator | Slashing, % | Reporters |\n|---|---:|---|\n")
+
+		for _, validatorReport := range report.ValidatorReports {
+			fmt.Fprintf(&builder, "| %s | %.2f | %s |\n", validatorReport.Name, validatorReport.SlashingPercentage, strings.Join(validatorReport.Reporters, ", "))
+		}
+	}
+
+	return builder.String()
+}
+
+// writeEpochValidators writes the section listing the validators, or None if there are none.
+func writeEpochValidators(builder *strings.Builder, title string, validators []EpochValidator) {
+	fmt.Fprintf(builder, "## %s\n\n", title)
+
+	if len(validators) == 0 {
+		builder.WriteString("None.\n\n")
+
+		return
+	}
+
+	for _, validator := range validators {
+		fmt.Fprintf(builder, "- %s `%s`\n", validator.Name, validator.Address)
+	}
+
+	builder.WriteString("\n")
+}
+
+// getPendingRemovals returns the active validators scheduled to be removed at the end of the epoch.
+// The pending removals are reported as indices into the active validators, as numbers or strings.
+func (systemState *SuiSystemState) getPendingRemovals() ([]EpochValidator, error) {
+	removals := make([]EpochValidator, 0, len(systemState.PendingRemovals))
+
+	for _, removal := range systemState.PendingRemovals {
+		var idx int
+
+		switch typedRemoval := removal.(type) {
+		case float64:
+			idx = int(typedRemoval)
+		case string:
+			parsed, err := strconv.Atoi(typedRemoval)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected pending removal: %v", removal)
+			}
+
+			idx = parsed
+		default:
+			return nil, fmt.Errorf("unexpected pending removal: %v", removal)
+		}
+
+		if idx < 0 || idx >= len(systemState.ActiveValidators) {
+			return nil, fmt.Errorf("pending removal index out of range: %d", idx)
+		}
+
+		removals = append(removals, newEpochValidator(systemState.ActiveValidators[idx]))
+	}
+
+	return removals, nil
+}
+
+// newEpochStakeChange returns the change of the staking pool balance of the validator between the epochs.
+func newEpochStakeChange(previous, current *Validator) (EpochStakeChange, error) {
+	stake, err := newEpochValueChange(previous.StakingPoolSuiBalance, current.StakingPoolSuiBalance, true)
+	if err != nil {
+		return EpochStakeChange{}, err
+	}
+
+	return EpochStakeChange{
+		EpochValidator:   newEpochValidator(current),
+		PreviousStakeSui: stake.Previous,
+		StakeSui:         stake.Current,
+		DeltaSui:         stake.Delta,
+	}, nil
+}
+
+// newEpochValueChange parses the previous and the current values, converting them from MIST to SUI if inSui is set.
+// The delta is computed before the conversion, so that it is not lost to rounding.
+func newEpochValueChange(previous, current string, inSui bool) (EpochValueChange, error) {
+	previousValue, ok := new(big.Int).SetString(previous, base10)
+	if !ok {
+		return EpochValueChange{}, fmt.Errorf("unexpected metric value type: %s", previous)
+	}
+
+	currentValue, ok := new(big.Int).SetString(current, base10)
+	if !ok {
+		return EpochValueChange{}, fmt.Errorf("unexpected metric value type: %s", current)
+	}
+
+	delta := new(big.Int).Sub(currentValue, previousValue)
+
+	if inSui {
+		rate := big.NewInt(suiRate)
+
+		previousValue.Quo(previousValue, rate)
+		currentValue.Quo(currentValue, rate)
+		delta.Quo(delta, rate)
+	}
+
+	return EpochValueChange{
+		Previous: previousValue.Int64(),
+		Current:  currentValue.Int64(),
+		Delta:    delta.Int64(),
+	}, nil
+}
+
+// sumMist returns the sum of the MIST amounts as a string.
+func sumMist(amounts ...string) (string, error) {
+	sum := new(big.Int)
+
+	for _, amount := range amounts {
+		value, ok := new(big.Int).SetString(amount, base10)
+		if !ok {
+			return "", fmt.Errorf("unexpected metric value type: %s", amount)
+		}
+
+		sum.Add(sum, value)
+	}
+
+	return sum.String(), nil
+}
+
+// getValidatorsByAddress maps the validators by their Sui address.
+func getValidatorsByAddress(validators Validators) AddressToValidator {
+	byAddress := make(AddressToValidator, len(validators))
+	for _, validator := range validators {
+		byAddress[validator.SuiAddress] = validator
+	}
+
+	return byAddress
+}
+
+// newEpochValidator returns the name and the address of the validator.
+func newEpochValidator(validator *Validator) EpochValidator {
+	return EpochValidator{
+		Name:    validator.Name,
+		Address: validator.SuiAddress,
+	}
+}
+
+// absInt64 returns the absolute value of the integer.
+func absInt64(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
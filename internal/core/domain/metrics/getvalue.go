@@ -69,8 +69,10 @@ func (metrics *Metrics) GetValue(metric enums.MetricType) MetricValue {
 		return metrics.SkippedConsensusTransactions
 	case enums.MetricTypeTotalSignatureErrors:
 		return metrics.TotalSignatureErrors
-	case enums.MetricTypeNonConsensusLatencySum:
-		return metrics.NonConsensusLatency
+	case enums.MetricTypeCertificateNonConsensusLatency:
+		return metrics.CertificateNonConsensusLatency.Stats()
+	case enums.MetricTypeCertificateConsensusLatency:
+		return metrics.CertificateConsensusLatency.Stats()
 	case enums.MetricTypeValidatorsApy:
 		return nil
 	case enums.MetricTypeProtocol:
@@ -1,10 +1,8 @@
 package metrics
 
+import "github.com/bartosian/suimon/internal/pkg/histogram"
+
 const (
-	TransactionsPerSecondWindow     = 5
-	CheckpointsPerSecondWindow      = 5
-	RoundsPerSecondWindow           = 5
-	CertificatesPerSecondWindow     = 5
 	TransactionsPerSecondLag        = 5
 	CheckpointsPerSecondLag         = 10
 	LatestCheckpointLag             = 30
@@ -16,13 +14,10 @@ const (
 type (
 	// Transactions represents information about transactions on the Sui blockchain network.
 	Transactions struct {
-		TransactionsHistory                 []int
-		CertificatesHistory                 []int
 		TotalTransactionsBlocks             int
 		TotalTransactionCertificates        int
 		TotalTransactionCertificatesCreated int
 		CertificatesPerSecond               int
-		NonConsensusLatency                 int
 		TotalTransactionEffects             int
 		TransactionsPerSecond               int
 		TxSyncPercentage                    int
@@ -30,7 +25,6 @@ type (
 
 	// Checkpoints represents information about checkpoints on the Sui blockchain network.
 	Checkpoints struct {
-		CheckpointsHistory      []int
 		LatestCheckpoint        int
 		HighestKnownCheckpoint  int
 		HighestSyncedCheckpoint int
@@ -43,7 +37,6 @@ type (
 
 	// Rounds represents information about rounds on the Sui blockchain network.
 	Rounds struct {
-		RoundsHistory                        []int
 		LastCommittedLeaderRound             int
 		HighestAcceptedRound                 int
 		RoundsPerSecond                      int
@@ -66,6 +59,13 @@ type (
 		TimeTillNextEpoch        int64
 	}
 
+	// Latencies represents the latency distributions reported by the validator.
+	// Each window keeps the previous scrape to compute the statistics for the observations made between polls.
+	Latencies struct {
+		CertificateNonConsensusLatency histogram.Window
+		CertificateConsensusLatency    histogram.Window
+	}
+
 	// Errors represents information about errors on the Sui blockchain network.
 	Errors struct {
 		SkippedConsensusTransactions int
@@ -90,11 +90,24 @@ type (
 	// Metrics represents various metrics about the Sui blockchain network.
 	Metrics struct {
 		ValidatorsApyParsed ValidatorsApyParsed
+		ValidatorsLocations ValidatorsLocations
+
+		// ValidatorSet keeps the changes of the validator set, if they were requested.
+		ValidatorSet *ValidatorSet
+
+		// SubsidyForecast keeps the forecast of the stake subsidy distributions, if it was requested.
+		SubsidyForecast *SubsidyForecast
+
+		// PreviousProtocol keeps the protocol config of the version preceding the current one, if it was requested.
+		PreviousProtocol *Protocol
 
 		Uptime  string
 		Version string
 		Commit  string
 
+		// VersionDrift compares the version run by the host with the releases of the network.
+		VersionDrift VersionDrift
+
 		SystemState SuiSystemState
 
 		CurrentVotingRight float64
@@ -108,6 +121,18 @@ type (
 		GasPrice
 		Peers
 		Errors
+		Latencies
+
+		// RateConfig defines how the rates of the counters are computed, Rates keeps the rates themselves.
+		RateConfig RateConfig
+		Rates      Rates
+
+		CustomMetrics CustomMetrics
+
+		// MetricErrors keeps the errors of the Prometheus metrics missing on the last update.
+		MetricErrors MetricErrors
+
+		// Updated is set once the metrics of the host were requested successfully.
 		Updated bool
 	}
 )
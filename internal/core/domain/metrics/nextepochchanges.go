@@ -0,0 +1,180 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// commissionRateDenominator is the denominator of the commission rate of the validators, set in basis points.
+const commissionRateDenominator = 100
+
+type (
+	// ValidatorNextEpochChange represents the parameters of a validator changing at the end of the epoch.
+	// The stakes are in SUI, the commission rates in percents and the rotations are the names of the keys
+	// and the addresses set for the next epoch.
+	ValidatorNextEpochChange struct {
+		Name                 string
+		Address              string
+		GasPrice             int64
+		NextGasPrice         int64
+		GasPriceDelta        int64
+		CommissionRate       float64
+		NextCommissionRate   float64
+		CommissionRateDelta  float64
+		Stake                int64
+		NextStake            int64
+		StakeDelta           int64
+		StakeDeltaPercentage float64
+		Rotations            []string
+	}
+
+	// nextEpochRotation is a key or an address of a validator that can be rotated at the end of the epoch.
+	nextEpochRotation struct {
+		name  string
+		value func(validator *Validator) any
+	}
+)
+
+// nextEpochRotations are the keys and the addresses of the validators with the next epoch values, in the order they are listed.
+var nextEpochRotations = []nextEpochRotation{
+	{"PROTOCOL KEY", func(validator *Validator) any { return validator.NextEpochProtocolPubkeyBytes }},
+	{"PROOF OF POSSESSION", func(validator *Validator) any { return validator.NextEpochProofOfPossession }},
+	{"NETWORK KEY", func(validator *Validator) any { return validator.NextEpochNetworkPubkeyBytes }},
+	{"WORKER KEY", func(validator *Validator) any { return validator.NextEpochWorkerPubkeyBytes }},
+	{"NET ADDRESS", func(validator *Validator) any { return validator.NextEpochNetAddress }},
+	{"P2P ADDRESS", func(validator *Validator) any { return validator.NextEpochP2PAddress }},
+	{"PRIMARY ADDRESS", func(validator *Validator) any { return validator.NextEpochPrimaryAddress }},
+	{"WORKER ADDRESS", func(validator *Validator) any { return validator.NextEpochWorkerAddress }},
+}
+
+// GetNextEpochChanges returns the validators the gas price, the commission rate or the stake of which change at the end
+// of the epoch, or with a key or an address rotation pending. They are sorted by impact: the absolute stake delta first,
+// as it moves the voting power, then the absolute commission rate and gas price deltas, with the rotations only last.
+func (validators Validators) GetNextEpochChanges() ([]ValidatorNextEpochChange, error) {
+	changes := make([]ValidatorNextEpochChange, 0)
+
+	for _, validator := range validators {
+		change, err := newValidatorNextEpochChange(validator)
+		if err != nil {
+			return nil, err
+		}
+
+		if change.GasPriceDelta == 0 && change.CommissionRateDelta == 0 && change.StakeDelta == 0 && len(change.Rotations) == 0 {
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(left, right int) bool {
+		leftChange, rightChange := changes[left], changes[right]
+
+		if leftStake, rightStake := absInt64(leftChange.StakeDelta), absInt64(rightChange.StakeDelta); leftStake != rightStake {
+			return leftStake > rightStake
+		}
+
+		if leftCommission, rightCommission := math.Abs(leftChange.CommissionRateDelta), math.Abs(rightChange.CommissionRateDelta); leftCommission != rightCommission {
+			return leftCommission > rightCommission
+		}
+
+		if leftGasPrice, rightGasPrice := absInt64(leftChange.GasPriceDelta), absInt64(rightChange.GasPriceDelta); leftGasPrice != rightGasPrice {
+			return leftGasPrice > rightGasPrice
+		}
+
+		return leftChange.Name < rightChange.Name
+	})
+
+	return changes, nil
+}
+
+// newValidatorNextEpochChange parses the current and the next epoch parameters of the validator and computes their deltas.
+func newValidatorNextEpochChange(validator *Validator) (ValidatorNextEpochChange, error) {
+	change := ValidatorNextEpochChange{
+		Name:    validator.Name,
+		Address: validator.SuiAddress,
+	}
+
+	integers := []struct {
+		name   string
+		value  string
+		target *int64
+	}{
+		{"GasPrice", validator.GasPrice, &change.GasPrice},
+		{"NextEpochGasPrice", validator.NextEpochGasPrice, &change.NextGasPrice},
+	}
+
+	for _, integer := range integers {
+		parsed, err := strconv.ParseInt(integer.value, base10, 64)
+		if err != nil {
+			return change, fmt.Errorf("unexpected metric value type for %s: %s", integer.name, integer.value)
+		}
+
+		*integer.target = parsed
+	}
+
+	rates := []struct {
+		name   string
+		value  string
+		target *float64
+	}{
+		{"CommissionRate", validator.CommissionRate, &change.CommissionRate},
+		{"NextEpochCommissionRate", validator.NextEpochCommissionRate, &change.NextCommissionRate},
+	}
+
+	for _, rate := range rates {
+		parsed, err := strconv.ParseInt(rate.value, base10, 64)
+		if err != nil {
+			return change, fmt.Errorf("unexpected metric value type for %s: %s", rate.name, rate.value)
+		}
+
+		*rate.target = float64(parsed) / commissionRateDenominator
+	}
+
+	stake, ok := new(big.Int).SetString(validator.StakingPoolSuiBalance, base10)
+	if !ok {
+		return change, fmt.Errorf("unexpected metric value type for StakingPoolSuiBalance: %s", validator.StakingPoolSuiBalance)
+	}
+
+	nextStake, ok := new(big.Int).SetString(validator.NextEpochStake, base10)
+	if !ok {
+		return change, fmt.Errorf("unexpected metric value type for NextEpochStake: %s", validator.NextEpochStake)
+	}
+
+	stakeDelta := new(big.Int).Sub(nextStake, stake)
+
+	change.Stake = new(big.Int).Div(stake, big.NewInt(suiRate)).Int64()
+	change.NextStake = new(big.Int).Div(nextStake, big.NewInt(suiRate)).Int64()
+	change.StakeDelta = new(big.Int).Quo(stakeDelta, big.NewInt(suiRate)).Int64()
+
+	if stake.Sign() > 0 {
+		change.StakeDeltaPercentage, _ = new(big.Float).Quo(new(big.Float).SetInt(stakeDelta), new(big.Float).SetInt(stake)).Float64()
+		change.StakeDeltaPercentage *= percentage100
+	}
+
+	change.GasPriceDelta = change.NextGasPrice - change.GasPrice
+	change.CommissionRateDelta = change.NextCommissionRate - change.CommissionRate
+
+	for _, rotation := range nextEpochRotations {
+		if isRotationPending(rotation.value(validator)) {
+			change.Rotations = append(change.Rotations, rotation.name)
+		}
+	}
+
+	return change, nil
+}
+
+// isRotationPending reports whether the next epoch value of a key or an address is set.
+// The value is nil if it is omitted, while some RPC providers report it as an empty string.
+func isRotationPending(value any) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return false
+	case string:
+		return typedValue != ""
+	default:
+		return true
+	}
+}
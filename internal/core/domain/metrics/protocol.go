@@ -1,53 +1,271 @@
 package metrics
 
-// Protocol represents the protocol information of the Sui blockchain network.
-// It includes the minimum and maximum supported protocol versions, the current protocol version, and the feature flags.
-type Protocol struct {
-	MinSupportedProtocolVersion string       `json:"minSupportedProtocolVersion"`
-	MaxSupportedProtocolVersion string       `json:"maxSupportedProtocolVersion"`
-	ProtocolVersion             string       `json:"protocolVersion"`
-	FeatureFlags                FeatureFlags `json:"featureFlags"`
-}
-
-// FeatureFlags represents the various feature flags of the Sui blockchain network.
-// Each flag represents a specific feature of the network that can be toggled on or off.
-type FeatureFlags struct {
-	AcceptZkloginInMultisig                  bool `json:"accept_zklogin_in_multisig"`
-	AdvanceEpochStartTimeInSafeMode          bool `json:"advance_epoch_start_time_in_safe_mode"`
-	AdvanceToHighestSupportedProtocolVersion bool `json:"advance_to_highest_supported_protocol_version"`
-	AllowReceivingObjectID                   bool `json:"allow_receiving_object_id"`
-	BanEntryInit                             bool `json:"ban_entry_init"`
-	CommitRootStateDigest                    bool `json:"commit_root_state_digest"`
-	ConsensusOrderEndOfEpochLast             bool `json:"consensus_order_end_of_epoch_last"`
-	DisableInvariantViolationCheckInSwapLoc  bool `json:"disable_invariant_violation_check_in_swap_loc"`
-	DisallowAddingAbilitiesOnUpgrade         bool `json:"disallow_adding_abilities_on_upgrade"`
-	DisallowChangeStructTypeParamsOnUpgrade  bool `json:"disallow_change_struct_type_params_on_upgrade"`
-	EnableEffectsV2                          bool `json:"enable_effects_v2"`
-	EnableJwkConsensusUpdates                bool `json:"enable_jwk_consensus_updates"`
-	EndOfEpochTransactionSupported           bool `json:"end_of_epoch_transaction_supported"`
-	HardenedOtwCheck                         bool `json:"hardened_otw_check"`
-	IncludeConsensusDigestInPrologue         bool `json:"include_consensus_digest_in_prologue"`
-	LoadedChildObjectFormat                  bool `json:"loaded_child_object_format"`
-	LoadedChildObjectFormatType              bool `json:"loaded_child_object_format_type"`
-	LoadedChildObjectsFixed                  bool `json:"loaded_child_objects_fixed"`
-	MissingTypeIsCompatibilityError          bool `json:"missing_type_is_compatibility_error"`
-	NarwhalCertificateV2                     bool `json:"narwhal_certificate_v2"`
-	NarwhalHeaderV2                          bool `json:"narwhal_header_v2"`
-	NarwhalNewLeaderElectionSchedule         bool `json:"narwhal_new_leader_election_schedule"`
-	NarwhalVersionedMetadata                 bool `json:"narwhal_versioned_metadata"`
-	NoExtraneousModuleBytes                  bool `json:"no_extraneous_module_bytes"`
-	PackageDigestHashModule                  bool `json:"package_digest_hash_module"`
-	PackageUpgrades                          bool `json:"package_upgrades"`
-	RandomBeacon                             bool `json:"random_beacon"`
-	ReceiveObjects                           bool `json:"receive_objects"`
-	RecomputeHasPublicTransferInExecution    bool `json:"recompute_has_public_transfer_in_execution"`
-	ScoringDecisionWithValidityCutoff        bool `json:"scoring_decision_with_validity_cutoff"`
-	SharedObjectDeletion                     bool `json:"shared_object_deletion"`
-	SimpleConservationChecks                 bool `json:"simple_conservation_checks"`
-	SimplifiedUnwrapThenDelete               bool `json:"simplified_unwrap_then_delete"`
-	ThroughputAwareConsensusSubmission       bool `json:"throughput_aware_consensus_submission"`
-	TxnBaseCostAsMultiplier                  bool `json:"txn_base_cost_as_multiplier"`
-	UpgradedMultisigSupported                bool `json:"upgraded_multisig_supported"`
-	VerifyLegacyZkloginAddress               bool `json:"verify_legacy_zklogin_address"`
-	ZkloginAuth                              bool `json:"zklogin_auth"`
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ProtocolValueAbsent is shown for the feature flags and attributes not defined in a protocol version.
+const ProtocolValueAbsent = "-"
+
+// ProtocolValueNone is shown for the attributes defined in a protocol version but not set.
+const ProtocolValueNone = "none"
+
+// ProtocolEntryKind is the kind of the protocol config entry.
+type ProtocolEntryKind string
+
+const (
+	ProtocolEntryKindFlag      ProtocolEntryKind = "FLAG"
+	ProtocolEntryKindAttribute ProtocolEntryKind = "ATTRIBUTE"
+)
+
+// ProtocolChange is the kind of the change of a protocol config entry between two protocol versions.
+type ProtocolChange string
+
+const (
+	ProtocolChangeAdded   ProtocolChange = "ADDED"
+	ProtocolChangeRemoved ProtocolChange = "REMOVED"
+	ProtocolChangeChanged ProtocolChange = "CHANGED"
+)
+
+type (
+	// Protocol represents the protocol information of the Sui blockchain network.
+	// It includes the minimum and maximum supported protocol versions, the current protocol version,
+	// and all the feature flags and attributes reported by the node.
+	Protocol struct {
+		MinSupportedProtocolVersion string         `json:"minSupportedProtocolVersion"`
+		MaxSupportedProtocolVersion string         `json:"maxSupportedProtocolVersion"`
+		ProtocolVersion             string         `json:"protocolVersion"`
+		FeatureFlags                ProtocolValues `json:"featureFlags"`
+		Attributes                  ProtocolValues `json:"attributes"`
+	}
+
+	// ProtocolValues is an ordered map of the feature flags or the attributes of the protocol config.
+	// The keys are kept in the order the node reports them in, and the values are formatted as strings.
+	ProtocolValues struct {
+		Keys   []string
+		Values map[string]string
+	}
+
+	// ProtocolEntry represents a feature flag or an attribute of the protocol config, compared with the previous protocol version.
+	// PreviousValue is set only if the value differs from the one in the previous version.
+	ProtocolEntry struct {
+		Kind          ProtocolEntryKind
+		Name          string
+		Value         string
+		PreviousValue string
+		Changed       bool
+	}
+)
+
+// HasSupportedVersions reports whether the range of the protocol versions supported by the network is known.
+// The range is NotAvailable for the reference RPC hosts which do not expose it, e.g. the GraphQL ones.
+func (protocol *Protocol) HasSupportedVersions() bool {
+	for _, version := range []string{protocol.MinSupportedProtocolVersion, protocol.MaxSupportedProtocolVersion} {
+		if version == "" || version == NotAvailable {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnmarshalJSON decodes the JSON object keeping the order of the keys. The attributes are reported
+// as objects keyed by the value type, e.g. {"u64": "1000"}, or null if unset, and are unwrapped.
+func (values *ProtocolValues) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("unexpected protocol config values: %s", data)
+	}
+
+	values.Keys = nil
+	values.Values = make(map[string]string)
+
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := keyToken.(string)
+		if !ok {
+			return fmt.Errorf("unexpected protocol config key: %v", keyToken)
+		}
+
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("failed to decode protocol config value %s: %w", key, err)
+		}
+
+		values.Keys = append(values.Keys, key)
+		values.Values[key] = formatProtocolValue(value)
+	}
+
+	_, err = decoder.Token()
+
+	return err
+}
+
+// MarshalJSON encodes the values as a JSON object, so the protocol config can be stored and compared later.
+func (values ProtocolValues) MarshalJSON() ([]byte, error) {
+	return json.Marshal(values.Values)
+}
+
+// Get returns the value of the key, or false if the key is not defined.
+func (values ProtocolValues) Get(key string) (string, bool) {
+	value, ok := values.Values[key]
+
+	return value, ok
+}
+
+// formatProtocolValue formats a decoded protocol config value, unwrapping the typed attribute values.
+func formatProtocolValue(value any) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return ProtocolValueNone
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case string:
+		return typedValue
+	case json.Number:
+		return typedValue.String()
+	case map[string]any:
+		for _, wrapped := range typedValue {
+			return formatProtocolValue(wrapped)
+		}
+
+		return ProtocolValueNone
+	default:
+		return fmt.Sprint(typedValue)
+	}
+}
+
+// GetEntries returns the feature flags followed by the attributes of the protocol config, in the order reported by the node.
+// If the previous protocol config is provided, the entries with a different value in it are marked as changed,
+// and the entries defined in the previous version only are appended with the ProtocolValueAbsent value.
+// If the filter is provided, only the entries with the name matching it are returned.
+func (protocol *Protocol) GetEntries(previous *Protocol, filter *regexp.Regexp) []ProtocolEntry {
+	entries := make([]ProtocolEntry, 0, len(protocol.FeatureFlags.Keys)+len(protocol.Attributes.Keys))
+
+	for _, kind := range []ProtocolEntryKind{ProtocolEntryKindFlag, ProtocolEntryKindAttribute} {
+		current := protocol.getValues(kind)
+
+		var earlier ProtocolValues
+		if previous != nil {
+			earlier = previous.getValues(kind)
+		}
+
+		for _, name := range current.Keys {
+			entries = append(entries, newProtocolEntry(kind, name, current, earlier, previous != nil))
+		}
+
+		for _, name := range earlier.Keys {
+			if _, ok := current.Get(name); !ok {
+				entries = append(entries, newProtocolEntry(kind, name, current, earlier, true))
+			}
+		}
+	}
+
+	if filter == nil {
+		return entries
+	}
+
+	filtered := make([]ProtocolEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if filter.MatchString(entry.Name) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// GetChanges returns the entries of the protocol config added, removed or changed since the earlier protocol config.
+// If the filter is provided, only the entries with the name matching it are returned.
+func (protocol *Protocol) GetChanges(earlier *Protocol, filter *regexp.Regexp) []ProtocolEntry {
+	entries := protocol.GetEntries(earlier, filter)
+	changes := make([]ProtocolEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.Changed {
+			changes = append(changes, entry)
+		}
+	}
+
+	return changes
+}
+
+// GetChange returns whether the entry was added, removed or changed since the previous protocol version.
+func (entry ProtocolEntry) GetChange() ProtocolChange {
+	switch {
+	case entry.PreviousValue == ProtocolValueAbsent:
+		return ProtocolChangeAdded
+	case entry.Value == ProtocolValueAbsent:
+		return ProtocolChangeRemoved
+	default:
+		return ProtocolChangeChanged
+	}
+}
+
+// newProtocolEntry creates the entry for the name, comparing its current value with the earlier one if compared is set.
+func newProtocolEntry(kind ProtocolEntryKind, name string, current, earlier ProtocolValues, compared bool) ProtocolEntry {
+	entry := ProtocolEntry{
+		Kind:  kind,
+		Name:  name,
+		Value: ProtocolValueAbsent,
+	}
+
+	if value, ok := current.Get(name); ok {
+		entry.Value = value
+	}
+
+	if !compared {
+		return entry
+	}
+
+	previousValue, ok := earlier.Get(name)
+	if !ok {
+		previousValue = ProtocolValueAbsent
+	}
+
+	if previousValue != entry.Value {
+		entry.PreviousValue = previousValue
+		entry.Changed = true
+	}
+
+	return entry
+}
+
+// getValues returns the feature flags or the attributes of the protocol config.
+func (protocol *Protocol) getValues(kind ProtocolEntryKind) ProtocolValues {
+	if kind == ProtocolEntryKindFlag {
+		return protocol.FeatureFlags
+	}
+
+	return protocol.Attributes
+}
+
+// GetPreviousVersion returns the protocol version preceding the current one, or false if there is none.
+func (protocol *Protocol) GetPreviousVersion() (string, bool) {
+	version, err := strconv.ParseUint(protocol.ProtocolVersion, base10, 64)
+	if err != nil || version <= 1 {
+		return "", false
+	}
+
+	return strconv.FormatUint(version-1, base10), true
 }
@@ -0,0 +1,288 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+const (
+	DefaultRateWindow = 30 * time.Second
+	secondsInMinute   = 60
+)
+
+type (
+	// RateConfig defines how the rates of the counters are computed.
+	// In the window mode the rate is the increase of the counter over the samples within the window divided by the time between them.
+	// In the EWMA mode the rates between consecutive samples are exponentially averaged using the window as the time constant.
+	RateConfig struct {
+		Mode   enums.RateMode
+		Window time.Duration
+	}
+
+	// Rate computes the rate of a monotonically increasing counter from timestamped samples.
+	// A decrease of the counter, e.g. after a node restart, is treated as a reset: the counter is assumed
+	// to start from zero again, so no negative rate is reported and the increase after the reset is kept.
+	// It is safe for concurrent use, as the RPC calls and the Prometheus scrapes of a host update the rates concurrently.
+	Rate struct {
+		lock      sync.Mutex
+		config    RateConfig
+		samples   []rateSample
+		lastValue float64
+		offset    float64
+		average   float64
+		external  *float64
+		ready     bool
+	}
+
+	// Rates keeps the rate of every counter metric observed. It is created with all the rates by NewRates
+	// and only read afterwards, so it can be shared by the goroutines updating the metrics.
+	Rates map[enums.MetricType]*Rate
+
+	rateSample struct {
+		at    time.Time
+		value float64
+	}
+)
+
+// rateMetricTypes lists the counter metrics the rates are computed for.
+var rateMetricTypes = map[enums.MetricType]struct{}{
+	enums.MetricTypeTotalTransactionBlocks:              {},
+	enums.MetricTypeTotalTransactionEffects:             {},
+	enums.MetricTypeTotalTransactionCertificatesCreated: {},
+	enums.MetricTypeHighestSyncedCheckpoint:             {},
+	enums.MetricTypeLastExecutedCheckpoint:              {},
+	enums.MetricTypeConsensusHighestAcceptedRound:       {},
+	enums.MetricTypeSkippedConsensusTransactions:        {},
+	enums.MetricTypeTotalSignatureErrors:                {},
+	enums.MetricTypeNumberSharedObjectTransactions:      {},
+}
+
+// NewRate creates a new Rate with the given configuration.
+// The sliding window mode and the DefaultRateWindow are used for the unset config values.
+func NewRate(config RateConfig) *Rate {
+	if config.Mode == "" {
+		config.Mode = enums.RateModeWindow
+	}
+
+	if config.Window <= 0 {
+		config.Window = DefaultRateWindow
+	}
+
+	return &Rate{config: config}
+}
+
+// NewRates creates the rates of all the counter metrics with the given configuration.
+func NewRates(config RateConfig) Rates {
+	rates := make(Rates, len(rateMetricTypes))
+
+	for metric := range rateMetricTypes {
+		rates[metric] = NewRate(config)
+	}
+
+	return rates
+}
+
+// Add records the value of the counter observed at the given time.
+// Samples that are not newer than the last one are ignored.
+func (r *Rate) Add(value float64, at time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.samples) > 0 {
+		last := r.samples[len(r.samples)-1]
+		if !at.After(last.at) {
+			return
+		}
+
+		if value < r.lastValue {
+			r.offset += r.lastValue
+		}
+	}
+
+	r.lastValue = value
+
+	current := rateSample{at: at, value: value + r.offset}
+
+	if r.config.Mode == enums.RateModeEWMA {
+		r.addAverage(current)
+
+		return
+	}
+
+	r.addWindow(current)
+}
+
+// addAverage updates the exponentially weighted moving average with the rate since the previous sample.
+func (r *Rate) addAverage(current rateSample) {
+	defer func() {
+		r.samples = []rateSample{current}
+	}()
+
+	if len(r.samples) == 0 {
+		return
+	}
+
+	previous := r.samples[0]
+	elapsed := current.at.Sub(previous.at).Seconds()
+	instant := (current.value - previous.value) / elapsed
+
+	if !r.ready {
+		r.average = instant
+		r.ready = true
+
+		return
+	}
+
+	alpha := 1 - math.Exp(-elapsed/r.config.Window.Seconds())
+	r.average += alpha * (instant - r.average)
+}
+
+// addWindow appends the sample and drops the samples that fell out of the window.
+// The newest sample older than the window start is kept so the whole window is covered.
+func (r *Rate) addWindow(current rateSample) {
+	r.samples = append(r.samples, current)
+
+	windowStart := current.at.Add(-r.config.Window)
+
+	var idx int
+	for idx+1 < len(r.samples) && !r.samples[idx+1].at.After(windowStart) {
+		idx++
+	}
+
+	r.samples = r.samples[idx:]
+	r.ready = len(r.samples) > 1 || r.external != nil
+}
+
+// Set sets the per-second rate computed elsewhere, e.g. by the Prometheus server, overriding the one computed from the samples.
+func (r *Rate) Set(perSecond float64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.external = &perSecond
+	r.ready = true
+}
+
+// Ready reports whether enough samples were recorded to compute the rate.
+func (r *Rate) Ready() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.ready
+}
+
+// PerSecond returns the rate of the counter per second.
+// It returns 0 if the rate is not ready yet.
+func (r *Rate) PerSecond() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.ready {
+		return 0
+	}
+
+	if r.external != nil {
+		return *r.external
+	}
+
+	if r.config.Mode == enums.RateModeEWMA {
+		return r.average
+	}
+
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+
+	return (last.value - first.value) / last.at.Sub(first.at).Seconds()
+}
+
+// PerMinute returns the rate of the counter per minute.
+// It returns 0 if the rate is not ready yet.
+func (r *Rate) PerMinute() float64 {
+	return r.PerSecond() * secondsInMinute
+}
+
+// FormatPerSecond returns the rate per second rounded to one decimal or an empty string if the rate is not ready yet.
+func (r *Rate) FormatPerSecond() string {
+	return r.format(r.PerSecond())
+}
+
+// FormatPerMinute returns the rate per minute rounded to one decimal or an empty string if the rate is not ready yet.
+func (r *Rate) FormatPerMinute() string {
+	return r.format(r.PerMinute())
+}
+
+func (r *Rate) format(value float64) string {
+	if !r.Ready() {
+		return ""
+	}
+
+	return fmt.Sprintf("%.1f", value)
+}
+
+// Rate returns the rate of the given counter metric.
+// An empty rate is returned if the metric was not observed yet.
+func (metrics *Metrics) Rate(metric enums.MetricType) *Rate {
+	if rate, ok := metrics.Rates[metric]; ok {
+		return rate
+	}
+
+	return NewRate(metrics.RateConfig)
+}
+
+// observeRate records the current value of the counter metric in its rate
+// and updates the per-second metrics derived from it.
+func (metrics *Metrics) observeRate(metric enums.MetricType, at time.Time) {
+	if _, ok := rateMetricTypes[metric]; !ok {
+		return
+	}
+
+	value, ok := metrics.GetValue(metric).(int)
+	if !ok {
+		return
+	}
+
+	rate, ok := metrics.Rates[metric]
+	if !ok {
+		return
+	}
+
+	rate.Add(float64(value), at)
+
+	metrics.setPerSecond(metric, rate)
+}
+
+// SetRate sets the per-second rate of the counter metric computed elsewhere, e.g. by the Prometheus server.
+// Metrics the rates are not computed for, or not created with NewRates, are ignored.
+func (metrics *Metrics) SetRate(metric enums.MetricType, perSecond float64) {
+	if _, ok := rateMetricTypes[metric]; !ok {
+		return
+	}
+
+	rate, ok := metrics.Rates[metric]
+	if !ok {
+		return
+	}
+
+	rate.Set(perSecond)
+
+	metrics.setPerSecond(metric, rate)
+}
+
+// setPerSecond updates the per-second metric derived from the rate of the counter metric.
+func (metrics *Metrics) setPerSecond(metric enums.MetricType, rate *Rate) {
+	perSecond := int(math.Round(rate.PerSecond()))
+
+	//nolint: exhaustive // only the metrics with the per-second fields are handled
+	switch metric {
+	case enums.MetricTypeTotalTransactionBlocks:
+		metrics.TransactionsPerSecond = perSecond
+	case enums.MetricTypeHighestSyncedCheckpoint:
+		metrics.CheckpointsPerSecond = perSecond
+	case enums.MetricTypeConsensusHighestAcceptedRound:
+		metrics.RoundsPerSecond = perSecond
+	case enums.MetricTypeTotalTransactionCertificatesCreated:
+		metrics.CertificatesPerSecond = perSecond
+	}
+}
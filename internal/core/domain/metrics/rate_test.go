@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// TestSetValueConcurrent updates the counters from several goroutines, as the RPC calls and the Prometheus scrapes
+// of a host do, and is meant to be run with the race detector.
+func TestSetValueConcurrent(t *testing.T) {
+	metrics := Metrics{Rates: NewRates(RateConfig{})}
+
+	// The total transaction blocks are returned by the RPC call as a string, the others are scraped from Prometheus.
+	counters := map[enums.MetricType]func(value int) any{
+		enums.MetricTypeTotalTransactionBlocks:  func(value int) any { return strconv.Itoa(value) },
+		enums.MetricTypeTotalTransactionEffects: func(value int) any { return float64(value) },
+		enums.MetricTypeHighestSyncedCheckpoint: func(value int) any { return float64(value) },
+		enums.MetricTypeLastExecutedCheckpoint:  func(value int) any { return float64(value) },
+	}
+
+	const updates = 100
+
+	var wg sync.WaitGroup
+
+	// Each counter is updated by its own goroutine, as by a scrape, while the rates are read for rendering.
+	for counter, newValue := range counters {
+		wg.Add(2)
+
+		go func(counter enums.MetricType, newValue func(value int) any) {
+			defer wg.Done()
+
+			for value := 1; value <= updates; value++ {
+				if err := metrics.SetValue(counter, newValue(value)); err != nil {
+					t.Errorf("SetValue(%s) failed: %v", counter, err)
+
+					return
+				}
+
+				metrics.SetRate(counter, float64(value))
+			}
+		}(counter, newValue)
+
+		go func(counter enums.MetricType) {
+			defer wg.Done()
+
+			for value := 1; value <= updates; value++ {
+				metrics.Rate(counter).FormatPerSecond()
+			}
+		}(counter)
+	}
+
+	wg.Wait()
+
+	for counter := range counters {
+		if !metrics.Rate(counter).Ready() {
+			t.Errorf("rate of %s is not ready", counter)
+		}
+	}
+
+	if len(metrics.Rates) != len(rateMetricTypes) {
+		t.Errorf("expected %d rates, got %d", len(rateMetricTypes), len(metrics.Rates))
+	}
+}
+
+// TestRateAdd checks the rate of a counter over the window, including a reset of the counter.
+func TestRateAdd(t *testing.T) {
+	start := time.Unix(0, 0)
+	rate := NewRate(RateConfig{Window: time.Minute})
+
+	rate.Add(100, start)
+
+	if rate.Ready() {
+		t.Fatal("rate is ready after a single sample")
+	}
+
+	rate.Add(200, start.Add(10*time.Second))
+	rate.Add(50, start.Add(20*time.Second))
+
+	if got, want := rate.PerSecond(), 7.5; got != want {
+		t.Errorf("PerSecond() = %v, want %v", got, want)
+	}
+}
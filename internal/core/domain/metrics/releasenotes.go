@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// protocolVersionRegexp matches the protocol version mentioned in the release notes, e.g. "Protocol Version: 68".
+	protocolVersionRegexp = regexp.MustCompile(`(?i)protocol\s+version[^0-9\n]{0,40}?(\d+)`)
+
+	// UpgradeNoteRegexp matches the release notes marking the upgrade as mandatory or breaking.
+	UpgradeNoteRegexp = regexp.MustCompile(`(?i)\b(mandatory|breaking|must upgrade|upgrade (is )?required|required upgrade)\b`)
+)
+
+// ReleaseNotes represents the highlights extracted from the body of a release.
+// ProtocolVersion is the protocol version the release bumps to, empty if the notes do not mention one.
+type ReleaseNotes struct {
+	ProtocolVersion string
+	UpgradeNotes    []string
+}
+
+// GetNotes extracts the protocol version bump and the notes marking the upgrade as mandatory or breaking from the release body.
+func (release *Release) GetNotes() ReleaseNotes {
+	var notes ReleaseNotes
+
+	if match := protocolVersionRegexp.FindStringSubmatch(release.Body); match != nil {
+		notes.ProtocolVersion = match[1]
+	}
+
+	for _, line := range strings.Split(release.Body, "\n") {
+		if !UpgradeNoteRegexp.MatchString(line) {
+			continue
+		}
+
+		if note := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#*->")); note != "" {
+			notes.UpgradeNotes = append(notes.UpgradeNotes, note)
+		}
+	}
+
+	return notes
+}
+
+// GetReleasesSince returns the releases published after the release of the version, newest first, or false if the version is not in the releases.
+// The releases are matched by the version in the tag name, e.g. mainnet-v1.24.1. Draft releases are skipped.
+func GetReleasesSince(releases []Release, version string) ([]Release, bool) {
+	published := getPublishedReleases(releases)
+
+	releaseIdx := findReleaseByVersion(published, version)
+	if releaseIdx < 0 {
+		return nil, false
+	}
+
+	return published[:releaseIdx], true
+}
+
+// getPublishedReleases returns the releases that are not drafts.
+func getPublishedReleases(releases []Release) []Release {
+	published := make([]Release, 0, len(releases))
+
+	for _, release := range releases {
+		if !release.Draft {
+			published = append(published, release)
+		}
+	}
+
+	return published
+}
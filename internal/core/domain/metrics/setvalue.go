@@ -7,8 +7,10 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/pkg/histogram"
 	"github.com/bartosian/suimon/internal/pkg/utility"
 )
 
@@ -25,11 +27,10 @@ const (
 
 // SetValue updates a metric with the given value, parsing it if necessary.
 // It returns an error if the value type is not supported for the given metric.
+// Different metrics can be set concurrently, the Updated flag is set by the caller once all of them are.
 //
 //nolint:gocyclo // temporary disabled
 func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
-	metrics.Updated = true
-
 	var convFToI = func(input float64) int {
 		return int(math.Round(input))
 	}
@@ -54,7 +55,6 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.TotalTransactionsBlocks = valueInt
-		metrics.CalculateTransactionsRatio()
 	case enums.MetricTypeTotalTransactionCertificates:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -103,7 +103,6 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 
 		metrics.HighestSyncedCheckpoint = convFToI(valueFloat)
 
-		metrics.CalculateCheckpointsRatio()
 	case enums.MetricTypeLastExecutedCheckpoint:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -189,7 +188,6 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.HighestAcceptedRound = convFToI(valueFloat)
-		metrics.CalculateRoundsRatio()
 	case enums.MetricTypeConsensusRoundProberCurrentRoundGaps:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -211,7 +209,6 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.TotalTransactionCertificatesCreated = convFToI(valueFloat)
-		metrics.CalculateCertificatesRatio()
 	case enums.MetricTypeTotalSignatureErrors:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -219,13 +216,20 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.TotalSignatureErrors = convFToI(valueFloat)
-	case enums.MetricTypeNonConsensusLatencySum:
-		valueFloat, ok := value.(float64)
+	case enums.MetricTypeCertificateNonConsensusLatency:
+		snapshot, ok := value.(histogram.Snapshot)
 		if !ok {
 			return fmt.Errorf(ErrUnexpectedMetricValueType, metric, value)
 		}
 
-		metrics.NonConsensusLatency = convFToI(valueFloat)
+		metrics.CertificateNonConsensusLatency.Update(snapshot)
+	case enums.MetricTypeCertificateConsensusLatency:
+		snapshot, ok := value.(histogram.Snapshot)
+		if !ok {
+			return fmt.Errorf(ErrUnexpectedMetricValueType, metric, value)
+		}
+
+		metrics.CertificateConsensusLatency.Update(snapshot)
 	case enums.MetricTypeTxSyncPercentage:
 		valueInt, ok := value.(int)
 		if !ok {
@@ -249,6 +253,8 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		metrics.NumberSharedObjectTransactions = convFToI(valueFloat)
 	}
 
+	metrics.observeRate(metric, time.Now())
+
 	return nil
 }
 
@@ -391,114 +397,6 @@ func (metrics *Metrics) setRefGasPriceMetrics() error {
 	return nil
 }
 
-// CalculateTransactionsRatio calculates the current transaction per second (TPS) based on the number of transactions processed
-// within the current period. The TPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateTransactionsRatio() {
-	var (
-		transactionsHistory = metrics.TransactionsHistory
-		transactionsStart   int
-		transactionsEnd     int
-	)
-
-	transactionsHistory = append(transactionsHistory, metrics.TotalTransactionsBlocks)
-	if len(transactionsHistory) < TransactionsPerSecondWindow {
-		metrics.TransactionsHistory = transactionsHistory
-
-		return
-	}
-
-	if len(transactionsHistory) > TransactionsPerSecondWindow {
-		transactionsHistory = transactionsHistory[1:]
-	}
-
-	transactionsStart = transactionsHistory[0]
-	transactionsEnd = transactionsHistory[TransactionsPerSecondWindow-1]
-
-	metrics.TransactionsHistory = transactionsHistory
-	metrics.TransactionsPerSecond = transactionsEnd - transactionsStart
-}
-
-// CalculateCheckpointsRatio calculates the current checkpoints per second (CPS) based on the number of checkpoints generated
-// within the current period. The CPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateCheckpointsRatio() {
-	var (
-		checkpointsHistory = metrics.CheckpointsHistory
-		checkpointsStart   int
-		checkpointsEnd     int
-	)
-
-	checkpointsHistory = append(checkpointsHistory, metrics.HighestSyncedCheckpoint)
-	if len(checkpointsHistory) < CheckpointsPerSecondWindow {
-		metrics.CheckpointsHistory = checkpointsHistory
-
-		return
-	}
-
-	if len(checkpointsHistory) > CheckpointsPerSecondWindow {
-		checkpointsHistory = checkpointsHistory[1:]
-	}
-
-	checkpointsStart = checkpointsHistory[0]
-	checkpointsEnd = checkpointsHistory[CheckpointsPerSecondWindow-1]
-
-	metrics.CheckpointsHistory = checkpointsHistory
-	metrics.CheckpointsPerSecond = checkpointsEnd - checkpointsStart
-}
-
-// CalculateRoundsRatio calculates the current rounds per second (RPS) based on the number of rounds processed
-// within the current period. The RPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateRoundsRatio() {
-	var (
-		roundsHistory = metrics.RoundsHistory
-		roundsStart   int
-		roundsEnd     int
-	)
-
-	roundsHistory = append(roundsHistory, metrics.HighestAcceptedRound)
-	if len(roundsHistory) < RoundsPerSecondWindow {
-		metrics.RoundsHistory = roundsHistory
-
-		return
-	}
-
-	if len(roundsHistory) > RoundsPerSecondWindow {
-		roundsHistory = roundsHistory[1:]
-	}
-
-	roundsStart = roundsHistory[0]
-	roundsEnd = roundsHistory[RoundsPerSecondWindow-1]
-
-	metrics.RoundsHistory = roundsHistory
-	metrics.RoundsPerSecond = roundsEnd - roundsStart
-}
-
-// CalculateCertificatesRatio calculates the current certificates per second (CPS) based on the number of certificates created
-// within the current period. The CPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateCertificatesRatio() {
-	var (
-		certificatesHistory = metrics.CertificatesHistory
-		certificatesStart   int
-		certificatesEnd     int
-	)
-
-	certificatesHistory = append(certificatesHistory, metrics.TotalTransactionCertificatesCreated)
-	if len(certificatesHistory) < CertificatesPerSecondWindow {
-		metrics.CertificatesHistory = certificatesHistory
-
-		return
-	}
-
-	if len(certificatesHistory) > CertificatesPerSecondWindow {
-		certificatesHistory = certificatesHistory[1:]
-	}
-
-	certificatesStart = certificatesHistory[0]
-	certificatesEnd = certificatesHistory[CertificatesPerSecondWindow-1]
-
-	metrics.CertificatesHistory = certificatesHistory
-	metrics.CertificatesPerSecond = certificatesEnd - certificatesStart
-}
-
 // IsHealthy checks if the given metric's value satisfies the threshold defined for it.
 // If the metric type is not recognized, returns true.
 // The valueRPC argument is the value retrieved from the Sui RPC endpoint for the corresponding metric.
@@ -575,21 +473,31 @@ func (validators Validators) GetMinRefGasPrice() (int, error) {
 }
 
 func (metrics *Metrics) SetProtocolValue(value any) error {
+	protocol, err := ParseProtocol(value)
+	if err != nil {
+		return err
+	}
+
+	metrics.Protocol = *protocol
+
+	return nil
+}
+
+// ParseProtocol parses the protocol config returned by the sui_getProtocolConfig RPC method.
+func ParseProtocol(value any) (*Protocol, error) {
 	// Parse the JSON data of the Protocol object.
-	dataBytes, err := json.Marshal(value.(map[string]interface{}))
+	dataBytes, err := json.Marshal(value)
 	if err != nil {
-		return fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeProtocol, value)
+		return nil, fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeProtocol, value)
 	}
 
 	// Unmarshal the JSON data into a Protocol struct.
 	var protocol Protocol
 	if err = json.Unmarshal(dataBytes, &protocol); err != nil {
-		return fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeProtocol, value)
+		return nil, fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeProtocol, value)
 	}
 
-	metrics.Protocol = protocol
-
-	return nil
+	return &protocol, nil
 }
 
 // MistToSui converts a string representing a value in "mist" units to its
@@ -0,0 +1,260 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+const (
+	// votingPowerTotal is the voting power shared by the active validators, as defined in the voting_power Sui module.
+	votingPowerTotal = 10_000
+	// maxVotingPower is the cap of the voting power of a single validator, as defined in the voting_power Sui module.
+	maxVotingPower = 1_000
+
+	// votingPowerEpsilon is the voting power left undistributed when capping the voting power of the validators.
+	votingPowerEpsilon = 1e-9
+)
+
+// StakeTopN defines the number of the largest validators the stake share is computed for.
+var StakeTopN = []int{5, 10, 20}
+
+// VotingPowerBuckets defines the upper bounds, in percents of the total voting power, of the voting power histogram buckets.
+// The last bucket has no upper bound.
+var VotingPowerBuckets = []float64{0.5, 1, 2, 5, 10}
+
+type (
+	// StakeDistribution represents the concentration of the stake and the voting power of the active validators.
+	StakeDistribution struct {
+		Validators         int
+		TotalStake         *big.Int
+		NakamotoOneThird   int
+		NakamotoTwoThirds  int
+		Gini               float64
+		TopNShares         []float64
+		VotingPowerBuckets []int
+	}
+
+	// StakeDistributions represents the stake distribution of the current epoch and the one expected in the next epoch.
+	StakeDistributions struct {
+		Current   StakeDistribution
+		NextEpoch StakeDistribution
+	}
+)
+
+// GetStakeDistributions computes the stake distribution of the current epoch from the staking pool balances and the voting power
+// of the validators, and the one expected in the next epoch from their next epoch stake.
+// The next epoch voting power is derived from the stake the same way the network does, capping the voting power of each validator.
+func (validators Validators) GetStakeDistributions() (*StakeDistributions, error) {
+	currentStakes := make([]*big.Int, 0, len(validators))
+	currentVotingPowers := make([]float64, 0, len(validators))
+	nextStakes := make([]*big.Int, 0, len(validators))
+
+	for _, validator := range validators {
+		votingPower, err := strconv.ParseInt(validator.VotingPower, base10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected metric value type for VotingPower: %s", validator.VotingPower)
+		}
+
+		currentStake, ok := new(big.Int).SetString(validator.StakingPoolSuiBalance, base10)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric value type for StakingPoolSuiBalance: %s", validator.StakingPoolSuiBalance)
+		}
+
+		nextStake, ok := new(big.Int).SetString(validator.NextEpochStake, base10)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metric value type for NextEpochStake: %s", validator.NextEpochStake)
+		}
+
+		currentStakes = append(currentStakes, currentStake)
+		currentVotingPowers = append(currentVotingPowers, float64(votingPower))
+		nextStakes = append(nextStakes, nextStake)
+	}
+
+	return &StakeDistributions{
+		Current:   newStakeDistribution(currentStakes, currentVotingPowers),
+		NextEpoch: newStakeDistribution(nextStakes, deriveVotingPowers(nextStakes)),
+	}, nil
+}
+
+// newStakeDistribution computes the stake distribution for the provided stakes in MIST and the matching voting powers.
+func newStakeDistribution(stakes []*big.Int, votingPowers []float64) StakeDistribution {
+	totalStake := new(big.Int)
+	stakesSui := make([]float64, 0, len(stakes))
+
+	for _, stake := range stakes {
+		totalStake.Add(totalStake, stake)
+
+		stakeSui, _ := new(big.Float).Quo(new(big.Float).SetInt(stake), big.NewFloat(suiRate)).Float64()
+		stakesSui = append(stakesSui, stakeSui)
+	}
+
+	sortedVotingPowers := append([]float64(nil), votingPowers...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sortedVotingPowers)))
+
+	var sumVotingPower float64
+	for _, votingPower := range sortedVotingPowers {
+		sumVotingPower += votingPower
+	}
+
+	distribution := StakeDistribution{
+		Validators:         len(stakes),
+		TotalStake:         new(big.Int).Div(totalStake, big.NewInt(suiRate)),
+		NakamotoOneThird:   getNakamotoCoefficient(sortedVotingPowers, sumVotingPower, nakamotoThreshold),
+		NakamotoTwoThirds:  getNakamotoCoefficient(sortedVotingPowers, sumVotingPower, nakamotoThresholdTwoThirds),
+		Gini:               getGiniCoefficient(stakesSui),
+		TopNShares:         getTopNShares(stakesSui),
+		VotingPowerBuckets: getVotingPowerHistogram(votingPowers, sumVotingPower),
+	}
+
+	return distribution
+}
+
+// getNakamotoCoefficient returns the minimum number of validators controlling more than the threshold share of the voting power.
+// The voting powers are expected to be sorted in descending order.
+func getNakamotoCoefficient(sortedVotingPowers []float64, sumVotingPower, threshold float64) int {
+	var cumulativeVotingPower float64
+
+	for idx, votingPower := range sortedVotingPowers {
+		cumulativeVotingPower += votingPower
+
+		if cumulativeVotingPower > sumVotingPower*threshold {
+			return idx + 1
+		}
+	}
+
+	return len(sortedVotingPowers)
+}
+
+// getGiniCoefficient returns the Gini coefficient of the stakes, 0 meaning the stake is equally distributed
+// across the validators and 1 meaning it is held by a single validator.
+func getGiniCoefficient(stakes []float64) float64 {
+	count := len(stakes)
+	if count == 0 {
+		return 0
+	}
+
+	sortedStakes := append([]float64(nil), stakes...)
+	sort.Float64s(sortedStakes)
+
+	var sumStakes, weightedSum float64
+
+	for idx, stake := range sortedStakes {
+		sumStakes += stake
+		weightedSum += float64(idx+1) * stake
+	}
+
+	if sumStakes == 0 {
+		return 0
+	}
+
+	return (2*weightedSum)/(float64(count)*sumStakes) - float64(count+1)/float64(count)
+}
+
+// getTopNShares returns the share of the total stake held by the largest validators for each of StakeTopN.
+func getTopNShares(stakes []float64) []float64 {
+	sortedStakes := append([]float64(nil), stakes...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(sortedStakes)))
+
+	var sumStakes float64
+	for _, stake := range sortedStakes {
+		sumStakes += stake
+	}
+
+	shares := make([]float64, len(StakeTopN))
+
+	if sumStakes == 0 {
+		return shares
+	}
+
+	for idx, topN := range StakeTopN {
+		var topStake float64
+
+		for _, stake := range sortedStakes[:min(topN, len(sortedStakes))] {
+			topStake += stake
+		}
+
+		shares[idx] = topStake / sumStakes
+	}
+
+	return shares
+}
+
+// getVotingPowerHistogram returns the number of validators in each of VotingPowerBuckets, including the last unbounded one.
+func getVotingPowerHistogram(votingPowers []float64, sumVotingPower float64) []int {
+	buckets := make([]int, len(VotingPowerBuckets)+1)
+
+	if sumVotingPower == 0 {
+		return buckets
+	}
+
+	for _, votingPower := range votingPowers {
+		share := votingPower / sumVotingPower * percentage100
+
+		bucket := sort.Search(len(VotingPowerBuckets), func(idx int) bool {
+			return share < VotingPowerBuckets[idx]
+		})
+
+		buckets[bucket]++
+	}
+
+	return buckets
+}
+
+// deriveVotingPowers derives the voting power of the validators from their stakes. The voting power is proportional
+// to the stake, but capped at maxVotingPower, with the excess redistributed across the validators below the cap.
+func deriveVotingPowers(stakes []*big.Int) []float64 {
+	votingPowers := make([]float64, len(stakes))
+	capped := make([]bool, len(stakes))
+
+	stakesFloat := make([]float64, len(stakes))
+	for idx, stake := range stakes {
+		stakesFloat[idx], _ = new(big.Float).SetInt(stake).Float64()
+	}
+
+	remaining := float64(votingPowerTotal)
+
+	for remaining > votingPowerEpsilon {
+		var uncappedStake float64
+
+		for idx, stake := range stakesFloat {
+			if !capped[idx] {
+				uncappedStake += stake
+			}
+		}
+
+		if uncappedStake == 0 {
+			break
+		}
+
+		distributed := remaining
+		remaining = 0
+
+		for idx, stake := range stakesFloat {
+			if capped[idx] {
+				continue
+			}
+
+			votingPowers[idx] += distributed * stake / uncappedStake
+
+			if votingPowers[idx] >= maxVotingPower {
+				remaining += votingPowers[idx] - maxVotingPower
+				votingPowers[idx] = maxVotingPower
+				capped[idx] = true
+			}
+		}
+	}
+
+	for idx := range votingPowers {
+		votingPowers[idx] = math.Round(votingPowers[idx])
+	}
+
+	return votingPowers
+}
+
+// FormatGini returns the Gini coefficient with three decimals.
+func FormatGini(gini float64) string {
+	return strconv.FormatFloat(gini, 'f', 3, 64)
+}
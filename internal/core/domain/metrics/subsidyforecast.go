@@ -0,0 +1,241 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/bartosian/suimon/internal/pkg/utility"
+)
+
+const (
+	// subsidyDecreaseRateDenominator is the denominator of the stake subsidy decrease rate, set in basis points.
+	subsidyDecreaseRateDenominator = 10_000
+
+	// MaxSubsidyForecastEpochs limits how far the depletion of the stake subsidy fund is looked for.
+	MaxSubsidyForecastEpochs = 100_000
+
+	daysInYear = 365
+)
+
+type (
+	// SubsidyForecast represents the forecast of the stake subsidy distributions, simulating the subsidy fund
+	// epoch by epoch as the network does: the current distribution amount is paid at the end of every epoch
+	// and is decreased by the decrease rate at the end of every subsidy period. The amounts are in SUI.
+	SubsidyForecast struct {
+		Epoch                  int64
+		EpochStart             time.Time
+		EpochDuration          time.Duration
+		BalanceSui             float64
+		CurrentDistributionSui float64
+		TotalStakeSui          float64
+		StakeSui               float64
+
+		// NextDecreaseEpoch is the last epoch paid the current distribution amount, decreased at its end.
+		NextDecreaseEpoch   int64
+		NextDistributionSui float64
+
+		// Periods are the subsidy periods forecast, with the amount distributed at the end of each of their epochs.
+		Periods                  []SubsidyForecastPeriod
+		DistributionsPerEpochSui []float64
+
+		// DepletionEpoch is the epoch the subsidy fund runs out at the end of, if it is within the forecast limit.
+		DepletionEpoch int64
+		Depleted       bool
+	}
+
+	// SubsidyForecastPeriod represents the epochs paid the same stake subsidy distribution amount.
+	// The first period is the remainder of the current one.
+	SubsidyForecastPeriod struct {
+		StartEpoch           int64
+		EndEpoch             int64
+		DistributionSui      float64
+		DistributedSui       float64
+		BalanceSui           float64
+		RewardsPerEpochSui   float64
+		RewardsApyPercentage float64
+	}
+)
+
+// NewSubsidyForecast creates the forecast of the stake subsidy distributions of the system state for the number of
+// subsidy periods, with the staking rewards per epoch estimated for the stake set in SUI. The rewards are the share of the stake
+// in the stake subsidy distributed, before the commission of the validator and without the gas fees.
+func NewSubsidyForecast(systemState *SuiSystemState, periods int, stakeSui float64) (*SubsidyForecast, error) {
+	if periods < 1 {
+		return nil, errors.New("at least one subsidy period is required")
+	}
+
+	values, err := parseSubsidyValues(systemState)
+	if err != nil {
+		return nil, err
+	}
+
+	if values.periodLength < 1 {
+		return nil, fmt.Errorf("unexpected stake subsidy period length: %s", systemState.StakeSubsidyPeriodLength)
+	}
+
+	epochStart, err := utility.ParseEpochTime(systemState.EpochStartTimestampMs)
+	if err != nil {
+		return nil, err
+	}
+
+	epochDuration, err := utility.StringMsToDuration(systemState.EpochDurationMs)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast := &SubsidyForecast{
+		Epoch:                  values.epoch,
+		EpochStart:             *epochStart,
+		EpochDuration:          epochDuration,
+		BalanceSui:             values.balance,
+		CurrentDistributionSui: values.distribution,
+		TotalStakeSui:          values.totalStake,
+		StakeSui:               stakeSui,
+	}
+
+	balance, distribution, counter := values.balance, values.distribution, values.counter
+
+	var period *SubsidyForecastPeriod
+
+	for offset := int64(0); offset < MaxSubsidyForecastEpochs; offset++ {
+		epoch := values.epoch + offset
+
+		if epoch < values.startEpoch {
+			continue
+		}
+
+		if period == nil {
+			period = &SubsidyForecastPeriod{StartEpoch: epoch, DistributionSui: distribution}
+		}
+
+		distributed := min(distribution, balance)
+		balance -= distributed
+		counter++
+
+		if len(forecast.Periods) < periods {
+			forecast.DistributionsPerEpochSui = append(forecast.DistributionsPerEpochSui, distributed)
+			period.DistributedSui += distributed
+		}
+
+		if balance <= 0 {
+			forecast.DepletionEpoch, forecast.Depleted = epoch, true
+		}
+
+		decreased := counter%values.periodLength == 0
+		if decreased {
+			if forecast.NextDecreaseEpoch == 0 {
+				forecast.NextDecreaseEpoch = epoch
+			}
+
+			distribution -= distribution * float64(values.decreaseRate) / subsidyDecreaseRateDenominator
+
+			if forecast.NextDistributionSui == 0 {
+				forecast.NextDistributionSui = distribution
+			}
+		}
+
+		if (decreased || forecast.Depleted) && len(forecast.Periods) < periods {
+			period.EndEpoch, period.BalanceSui = epoch, balance
+			forecast.Periods = append(forecast.Periods, forecast.newPeriod(*period))
+			period = nil
+		}
+
+		if forecast.Depleted {
+			break
+		}
+	}
+
+	return forecast, nil
+}
+
+// GetEpochStart returns the estimated start time of the epoch, assuming the epochs last the current epoch duration.
+func (forecast *SubsidyForecast) GetEpochStart(epoch int64) time.Time {
+	return forecast.EpochStart.Add(time.Duration(epoch-forecast.Epoch) * forecast.EpochDuration)
+}
+
+// newPeriod sets the staking rewards estimated for the stake in the period.
+func (forecast *SubsidyForecast) newPeriod(period SubsidyForecastPeriod) SubsidyForecastPeriod {
+	if forecast.TotalStakeSui <= 0 || forecast.StakeSui <= 0 {
+		return period
+	}
+
+	period.RewardsPerEpochSui = period.DistributionSui * forecast.StakeSui / forecast.TotalStakeSui
+
+	if forecast.EpochDuration > 0 {
+		epochsPerYear := float64(daysInYear*hoursInDay*time.Hour) / float64(forecast.EpochDuration)
+		period.RewardsApyPercentage = period.RewardsPerEpochSui / forecast.StakeSui * epochsPerYear * percentage100
+	}
+
+	return period
+}
+
+// subsidyValues keeps the values of the system state the stake subsidy forecast is computed from.
+type subsidyValues struct {
+	epoch        int64
+	startEpoch   int64
+	counter      int64
+	periodLength int64
+	decreaseRate int64
+	balance      float64
+	distribution float64
+	totalStake   float64
+}
+
+// parseSubsidyValues parses the stake subsidy values of the system state, converting the amounts from MIST to SUI.
+func parseSubsidyValues(systemState *SuiSystemState) (*subsidyValues, error) {
+	values := &subsidyValues{decreaseRate: int64(systemState.StakeSubsidyDecreaseRate)}
+
+	integers := []struct {
+		value  string
+		target *int64
+	}{
+		{systemState.Epoch, &values.epoch},
+		{systemState.StakeSubsidyStartEpoch, &values.startEpoch},
+		{systemState.StakeSubsidyDistributionCounter, &values.counter},
+		{systemState.StakeSubsidyPeriodLength, &values.periodLength},
+	}
+
+	for _, integer := range integers {
+		parsed, err := strconv.ParseInt(integer.value, base10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected metric value type: %s", integer.value)
+		}
+
+		*integer.target = parsed
+	}
+
+	amounts := []struct {
+		value  string
+		target *float64
+	}{
+		{systemState.StakeSubsidyBalance, &values.balance},
+		{systemState.StakeSubsidyCurrentDistributionAmount, &values.distribution},
+		{systemState.TotalStake, &values.totalStake},
+	}
+
+	for _, amount := range amounts {
+		parsed, err := mistToSuiFloat(amount.value)
+		if err != nil {
+			return nil, err
+		}
+
+		*amount.target = parsed
+	}
+
+	return values, nil
+}
+
+// mistToSuiFloat converts the MIST amount to SUI, keeping the fraction.
+func mistToSuiFloat(mist string) (float64, error) {
+	mistInt, ok := new(big.Int).SetString(mist, base10)
+	if !ok {
+		return 0, fmt.Errorf("unexpected metric value type: %s", mist)
+	}
+
+	sui, _ := new(big.Float).Quo(new(big.Float).SetInt(mistInt), big.NewFloat(suiRate)).Float64()
+
+	return sui, nil
+}
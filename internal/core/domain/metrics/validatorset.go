@@ -0,0 +1,250 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ValidatorSetChange is the kind of the change of the validator set a validator is part of.
+type ValidatorSetChange string
+
+const (
+	ValidatorSetChangeJoined         ValidatorSetChange = "JOINED"
+	ValidatorSetChangeLeft           ValidatorSetChange = "LEFT"
+	ValidatorSetChangePendingActive  ValidatorSetChange = "PENDING ACTIVE"
+	ValidatorSetChangePendingRemoval ValidatorSetChange = "PENDING REMOVAL"
+	ValidatorSetChangeCandidate      ValidatorSetChange = "CANDIDATE"
+)
+
+type (
+	// ValidatorSet represents the changes of the validator set of the Sui blockchain network: the validators
+	// joined and left since the snapshot of an earlier epoch, and the ones to join or leave at the end of the current epoch.
+	ValidatorSet struct {
+		Epoch         string
+		SinceEpoch    string
+		Joined        []ValidatorSetMember
+		Left          []ValidatorSetMember
+		PendingActive []ValidatorSetMember
+		Removals      []ValidatorSetMember
+		Candidates    []ValidatorSetMember
+	}
+
+	// ValidatorSetMember represents a validator of the validator set. The stakes are in MIST.
+	ValidatorSetMember struct {
+		Name           string `json:"name"`
+		Address        string `json:"address"`
+		Stake          string `json:"stake"`
+		NextEpochStake string `json:"nextEpochStake"`
+	}
+
+	// ValidatorSetEntry represents a validator with the change of the validator set it is part of.
+	ValidatorSetEntry struct {
+		ValidatorSetMember
+		Change ValidatorSetChange
+	}
+
+	// ValidatorSetSnapshot represents the active validators of a network seen in an epoch,
+	// stored to find out the validators joined and left on the later runs.
+	ValidatorSetSnapshot struct {
+		Network    string               `json:"network"`
+		Epoch      string               `json:"epoch"`
+		Time       time.Time            `json:"time"`
+		Validators []ValidatorSetMember `json:"validators"`
+	}
+
+	// DynamicFieldsPage represents a page of the dynamic fields of an object returned by suix_getDynamicFields.
+	DynamicFieldsPage struct {
+		Data []struct {
+			ObjectID string `json:"objectId"`
+		} `json:"data"`
+		NextCursor  any  `json:"nextCursor"`
+		HasNextPage bool `json:"hasNextPage"`
+	}
+)
+
+// NewValidatorSet creates the validator set of the system state with the pending active validators and the candidates
+// resolved from their tables. The pending removals are resolved to the active validators they point to.
+func NewValidatorSet(systemState *SuiSystemState, pendingActive, candidates []ValidatorSetMember) (*ValidatorSet, error) {
+	removals, err := systemState.getPendingRemovals()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := getValidatorsByAddress(systemState.ActiveValidators)
+
+	removalMembers := make([]ValidatorSetMember, 0, len(removals))
+	for _, removal := range removals {
+		removalMembers = append(removalMembers, newValidatorSetMember(validators[removal.Address]))
+	}
+
+	return &ValidatorSet{
+		Epoch:         systemState.Epoch,
+		PendingActive: pendingActive,
+		Removals:      removalMembers,
+		Candidates:    candidates,
+	}, nil
+}
+
+// NewValidatorSetSnapshot creates the snapshot of the active validators of the system state.
+func NewValidatorSetSnapshot(network string, systemState *SuiSystemState, now time.Time) ValidatorSetSnapshot {
+	validators := make([]ValidatorSetMember, 0, len(systemState.ActiveValidators))
+	for _, validator := range systemState.ActiveValidators {
+		validators = append(validators, newValidatorSetMember(validator))
+	}
+
+	return ValidatorSetSnapshot{
+		Network:    network,
+		Epoch:      systemState.Epoch,
+		Time:       now,
+		Validators: validators,
+	}
+}
+
+// SetChangesSince sets the validators joined and left since the snapshot, comparing it with the active validators of the system state.
+func (set *ValidatorSet) SetChangesSince(snapshot *ValidatorSetSnapshot, systemState *SuiSystemState) {
+	set.SinceEpoch = snapshot.Epoch
+	set.Joined, set.Left = nil, nil
+
+	snapshotValidators := make(map[string]struct{}, len(snapshot.Validators))
+	for _, validator := range snapshot.Validators {
+		snapshotValidators[validator.Address] = struct{}{}
+	}
+
+	for _, validator := range systemState.ActiveValidators {
+		if _, ok := snapshotValidators[validator.SuiAddress]; !ok {
+			set.Joined = append(set.Joined, newValidatorSetMember(validator))
+		}
+	}
+
+	validators := getValidatorsByAddress(systemState.ActiveValidators)
+
+	for _, validator := range snapshot.Validators {
+		if _, ok := validators[validator.Address]; !ok {
+			set.Left = append(set.Left, validator)
+		}
+	}
+}
+
+// GetEntries returns the validators joined and left since the snapshot, followed by the ones pending removal,
+// the pending active ones and the candidates.
+func (set *ValidatorSet) GetEntries() []ValidatorSetEntry {
+	groups := []struct {
+		change  ValidatorSetChange
+		members []ValidatorSetMember
+	}{
+		{ValidatorSetChangeJoined, set.Joined},
+		{ValidatorSetChangeLeft, set.Left},
+		{ValidatorSetChangePendingRemoval, set.Removals},
+		{ValidatorSetChangePendingActive, set.PendingActive},
+		{ValidatorSetChangeCandidate, set.Candidates},
+	}
+
+	var entries []ValidatorSetEntry
+
+	for _, group := range groups {
+		for _, member := range group.members {
+			entries = append(entries, ValidatorSetEntry{ValidatorSetMember: member, Change: group.change})
+		}
+	}
+
+	return entries
+}
+
+// ParseDynamicFieldsPage parses the page of the dynamic fields returned by suix_getDynamicFields.
+func ParseDynamicFieldsPage(result any) (*DynamicFieldsPage, error) {
+	dataBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected dynamic fields response: %w", err)
+	}
+
+	var page DynamicFieldsPage
+	if err := json.Unmarshal(dataBytes, &page); err != nil {
+		return nil, fmt.Errorf("unexpected dynamic fields response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// ParseDynamicFieldValue returns the value of the dynamic field object returned by sui_getObject with the content shown.
+func ParseDynamicFieldValue(result any) (any, error) {
+	value, ok := getMoveValue(result, "data", "content", "value")
+	if !ok {
+		return nil, fmt.Errorf("unexpected dynamic field object: %v", result)
+	}
+
+	return value, nil
+}
+
+// ParseValidatorSetMember parses the validator stored as a Move struct. It returns false if the struct is
+// a wrapper of a versioned validator, the id of the versioned object of which is returned instead.
+func ParseValidatorSetMember(value any) (ValidatorSetMember, string, bool, error) {
+	if _, ok := getMoveValue(value, "metadata"); !ok {
+		versionedID, ok := getMoveValue(value, "inner", "id", "id")
+		if !ok {
+			return ValidatorSetMember{}, "", false, fmt.Errorf("unexpected validator struct: %v", value)
+		}
+
+		id, ok := versionedID.(string)
+		if !ok {
+			return ValidatorSetMember{}, "", false, fmt.Errorf("unexpected versioned validator id: %v", versionedID)
+		}
+
+		return ValidatorSetMember{}, id, false, nil
+	}
+
+	return ValidatorSetMember{
+		Name:           getMoveString(value, "metadata", "name"),
+		Address:        getMoveString(value, "metadata", "sui_address"),
+		Stake:          getMoveString(value, "staking_pool", "sui_balance"),
+		NextEpochStake: getMoveString(value, "next_epoch_stake"),
+	}, "", true, nil
+}
+
+// getMoveValue returns the value at the path of the keys in the Move object, unwrapping the fields of the nested structs.
+func getMoveValue(value any, path ...string) (any, bool) {
+	for _, key := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		if fields, ok := object["fields"].(map[string]any); ok {
+			object = fields
+		}
+
+		if value, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// getMoveString returns the string value at the path of the keys in the Move object, or an empty string if there is none.
+func getMoveString(value any, path ...string) string {
+	field, ok := getMoveValue(value, path...)
+	if !ok {
+		return ""
+	}
+
+	if stringValue, ok := field.(string); ok {
+		return stringValue
+	}
+
+	return fmt.Sprint(field)
+}
+
+// newValidatorSetMember returns the validator set member of the active validator.
+func newValidatorSetMember(validator *Validator) ValidatorSetMember {
+	if validator == nil {
+		return ValidatorSetMember{}
+	}
+
+	return ValidatorSetMember{
+		Name:           validator.Name,
+		Address:        validator.SuiAddress,
+		Stake:          validator.StakingPoolSuiBalance,
+		NextEpochStake: validator.NextEpochStake,
+	}
+}
@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	VersionDriftLatest           = "LATEST"
+	VersionDriftOutdated         = "OUTDATED"
+	VersionDriftUnreleasedCommit = "UNRELEASED COMMIT"
+
+	hoursInDay = 24
+)
+
+// commitHashRegexp matches the full commit hashes, as the target of a release can be a branch name as well.
+var commitHashRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// VersionDrift represents how far the version run by a host is behind the latest release of the network.
+// Release is the release of the version run by the host, nil if the version is not in the releases.
+// UnreleasedCommit is set if the commit run by the host is not the one of any release.
+type VersionDrift struct {
+	Release          *Release
+	LatestTag        string
+	ReleasesBehind   int
+	BuildAgeDays     int
+	Checked          bool
+	UnreleasedCommit bool
+}
+
+// GetVersionDrift compares the version and commit run by a host with the releases of the network, ordered from the newest.
+// The release is matched by the commit first, and by the version in the tag name, e.g. mainnet-v1.24.1, otherwise.
+// The build age is counted from the publication of the release matched. Draft releases are skipped.
+func GetVersionDrift(releases []Release, version, commit string, now time.Time) VersionDrift {
+	published := getPublishedReleases(releases)
+
+	if len(published) == 0 || (version == "" && commit == "") {
+		return VersionDrift{}
+	}
+
+	drift := VersionDrift{
+		LatestTag: published[0].TagName,
+		Checked:   true,
+	}
+
+	releaseIdx := findReleaseByCommit(published, commit)
+	if releaseIdx < 0 {
+		releaseIdx = findReleaseByVersion(published, version)
+
+		// The commit of the release is known and differs from the one run by the host.
+		if releaseIdx >= 0 && commit != "" && commitHashRegexp.MatchString(published[releaseIdx].CommitHash) {
+			drift.UnreleasedCommit = true
+		}
+	}
+
+	if releaseIdx < 0 {
+		drift.UnreleasedCommit = true
+
+		return drift
+	}
+
+	release := published[releaseIdx]
+
+	drift.Release = &release
+	drift.ReleasesBehind = releaseIdx
+	drift.BuildAgeDays = -1
+
+	if publishedAt, err := time.Parse(time.RFC3339, release.PublishedAt); err == nil {
+		drift.BuildAgeDays = int(now.Sub(publishedAt).Hours() / hoursInDay)
+	}
+
+	return drift
+}
+
+// GetStatus returns whether the host runs the latest release, an older one or a commit not released.
+func (drift VersionDrift) GetStatus() string {
+	switch {
+	case !drift.Checked:
+		return NotAvailable
+	case drift.UnreleasedCommit:
+		return VersionDriftUnreleasedCommit
+	case drift.ReleasesBehind == 0:
+		return VersionDriftLatest
+	default:
+		return VersionDriftOutdated
+	}
+}
+
+// GetReleasesBehind returns the number of releases published after the one run by the host, or NotAvailable if it is not known.
+func (drift VersionDrift) GetReleasesBehind() any {
+	if drift.Release == nil {
+		return NotAvailable
+	}
+
+	return drift.ReleasesBehind
+}
+
+// GetBuildAgeDays returns the number of days since the release run by the host was published, or NotAvailable if it is not known.
+func (drift VersionDrift) GetBuildAgeDays() any {
+	if drift.Release == nil || drift.BuildAgeDays < 0 {
+		return NotAvailable
+	}
+
+	return drift.BuildAgeDays
+}
+
+// findReleaseByCommit returns the index of the release targeting the commit, or -1 if there is none.
+func findReleaseByCommit(releases []Release, commit string) int {
+	if commit == "" {
+		return -1
+	}
+
+	for idx, release := range releases {
+		if commitHashRegexp.MatchString(release.CommitHash) && strings.HasPrefix(release.CommitHash, commit) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// findReleaseByVersion returns the index of the release with the tag name ending with the version, or -1 if there is none.
+func findReleaseByVersion(releases []Release, version string) int {
+	if version == "" {
+		return -1
+	}
+
+	for idx, release := range releases {
+		if strings.HasSuffix(release.TagName, "v"+version) {
+			return idx
+		}
+	}
+
+	return -1
+}
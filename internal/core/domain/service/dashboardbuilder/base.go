@@ -3,7 +3,8 @@ package dashboardbuilder
 import (
 	"context"
 	"fmt"
-	"os"
+	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/keyboard"
@@ -14,49 +15,68 @@ import (
 	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 type Builder struct {
-	ctx        context.Context
-	cliGateway *cligw.Gateway
-	terminal   *termbox.Terminal
-	dashboard  *container.Container
-	host       *domainhost.Host
-	cells      dashboards.Cells
-	quitter    func(k *terminalapi.Keyboard)
-	tableType  enums.TableType
+	ctx            context.Context
+	cancel         context.CancelFunc
+	cliGateway     *cligw.Gateway
+	historyGateway ports.HistoryGateway
+	terminal       *termbox.Terminal
+	dashboard      *container.Container
+	host           *domainhost.Host
+	cells          dashboards.Cells
+	quitter        func(k *terminalapi.Keyboard)
+	historyKey     ports.HistoryKey
+	lastRecorded   time.Time
+	tableType      enums.TableType
+	closeOnce      sync.Once
 }
 
 // NewBuilder creates a new Builder instance with the provided CLI gateway.
-// It initializes the termbox terminal and dashboard, and sets up a context and quitter function.
+// The history gateway is optional, if set the sparklines are prefilled with the samples recorded under the history key
+// and the samples polled by the dashboard are recorded under it.
+// It initializes the termbox terminal and dashboard, and sets up a context derived from the provided one and a quitter function.
+// The quitter cancels the context, which stops the dashboard loops and lets Render return.
 // If an error occurs during initialization, it returns an error.
-func NewBuilder(tableType enums.TableType, host *domainhost.Host, cliGateway *cligw.Gateway) (*Builder, error) {
+func NewBuilder(
+	ctx context.Context,
+	tableType enums.TableType,
+	host *domainhost.Host,
+	cliGateway *cligw.Gateway,
+	historyGateway ports.HistoryGateway,
+	historyKey ports.HistoryKey,
+) (*Builder, error) {
 	terminal, err := termbox.New()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize termbox terminal: %w", err)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 
 	return &Builder{
-		ctx:        ctx,
-		tableType:  tableType,
-		cliGateway: cliGateway,
-		terminal:   terminal,
-		host:       host,
+		ctx:            ctx,
+		cancel:         cancel,
+		tableType:      tableType,
+		cliGateway:     cliGateway,
+		historyGateway: historyGateway,
+		historyKey:     historyKey,
+		terminal:       terminal,
+		host:           host,
 		quitter: func(k *terminalapi.Keyboard) {
 			if k.Key == 'q' || k.Key == 'Q' || k.Key == keyboard.KeyEsc || k.Key == keyboard.KeyCtrlC {
-				terminal.Close()
 				cancel()
-
-				os.Exit(0)
 			}
 		},
 	}, nil
 }
 
-// The tearDown function closes the Builder's terminal and cancels its context.
+// The tearDown function cancels the Builder's context and closes its terminal, restoring the terminal state.
+// It is safe to call it multiple times.
 func (db *Builder) tearDown() {
-	db.ctx.Done()
-	db.terminal.Close()
+	db.closeOnce.Do(func() {
+		db.cancel()
+		db.terminal.Close()
+	})
 }
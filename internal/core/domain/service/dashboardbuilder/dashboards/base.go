@@ -55,7 +55,7 @@ func GetColumnsConfig(dashboard enums.TableType) (ColumnsConfig, error) {
 	return config, nil
 }
 
-// GetColumnsValues returns the columns values based on the specified dashboard type and host.
+// GetColumnsValues returns the columns values based on the specified dashboard type and host, including the values of the custom metrics.
 func GetColumnsValues(dashboard enums.TableType, host *domainhost.Host) (ColumnValues, error) {
 	columnsValuesFuncMap := map[enums.TableType]func(*domainhost.Host) (ColumnValues, error){
 		enums.TableTypeNode:               GetNodeColumnValues,
@@ -65,7 +65,16 @@ func GetColumnsValues(dashboard enums.TableType, host *domainhost.Host) (ColumnV
 	}
 
 	if columnValuesFunc, ok := columnsValuesFuncMap[dashboard]; ok {
-		return columnValuesFunc(host)
+		columnValues, err := columnValuesFunc(host)
+		if err != nil {
+			return nil, err
+		}
+
+		for columnName, value := range GetCustomColumnValues(host) {
+			columnValues[columnName] = value
+		}
+
+		return columnValues, nil
 	}
 
 	return nil, fmt.Errorf("unknown dashboard type: %v", dashboard)
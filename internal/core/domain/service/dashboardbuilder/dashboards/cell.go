@@ -84,6 +84,17 @@ func (c *Cell) Write(value any) error {
 	return nil
 }
 
+// Prefill adds the values recorded before the dashboard was started to the cell sparkline widget, oldest first.
+// It does nothing for the other widget types.
+func (c *Cell) Prefill(values []int) error {
+	widget, ok := c.Widget.(*sparkline.SparkLine)
+	if !ok || len(values) == 0 {
+		return nil
+	}
+
+	return widget.Add(values)
+}
+
 // writeToTextWidget writes a string value to a text widget with the given options.
 // The function expects a slice of cell options and a value of type string,
 // and returns an error if the value has a different type. The function uses
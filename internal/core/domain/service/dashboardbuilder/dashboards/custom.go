@@ -0,0 +1,126 @@
+package dashboards
+
+import (
+	"github.com/mum4k/termdash/cell"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const (
+	customCellsPerRow  = 4
+	customRowsWidth    = 98
+	maxRowsTotalHeight = 100 - emptyRowHeight
+)
+
+// WithCustomCells returns a copy of the cells config extended with the cells of the custom metrics shown on the dashboard.
+func WithCustomCells(config CellsConfig, customMetrics domainmetrics.CustomMetrics) CellsConfig {
+	cells := make(CellsConfig, len(config)+len(customMetrics))
+	for columnName, cellConfig := range config {
+		cells[columnName] = cellConfig
+	}
+
+	for _, customMetric := range dashboardCustomMetrics(customMetrics) {
+		cells[customMetric.Column] = CellConfig{Title: customMetric.Title, Color: cell.ColorCyan}
+	}
+
+	return cells
+}
+
+// WithCustomColumns returns a copy of the columns config extended with the columns of the custom metrics shown on the dashboard.
+// The custom columns of a row share its width equally.
+func WithCustomColumns(config ColumnsConfig, customMetrics domainmetrics.CustomMetrics) ColumnsConfig {
+	columns := make(ColumnsConfig, len(config)+len(customMetrics))
+	for columnName, width := range config {
+		columns[columnName] = width
+	}
+
+	for _, row := range customRows(customMetrics) {
+		for _, columnName := range row {
+			columns[columnName] = customRowsWidth / len(row)
+		}
+	}
+
+	return columns
+}
+
+// WithCustomRows returns a copy of the rows config extended with the rows of the custom metrics shown on the dashboard.
+// If the rows do not fit the dashboard anymore, the height is split equally between all of them.
+func WithCustomRows(config RowsConfig, customMetrics domainmetrics.CustomMetrics) RowsConfig {
+	rows := make(RowsConfig, len(config))
+	copy(rows, config)
+
+	newRows := customRows(customMetrics)
+	if len(newRows) == 0 {
+		return rows
+	}
+
+	var totalHeight int
+	for _, row := range rows {
+		totalHeight += row.Height
+	}
+
+	for _, columns := range newRows {
+		rows = append(rows, RowConfig{Height: RowHeight12, Columns: columns})
+		totalHeight += RowHeight12
+	}
+
+	if totalHeight > maxRowsTotalHeight {
+		height := maxRowsTotalHeight / len(rows)
+
+		for idx := range rows {
+			rows[idx].Height = height
+		}
+	}
+
+	return rows
+}
+
+// GetCustomColumnValues returns the formatted values of the custom metrics of the host keyed by their columns.
+func GetCustomColumnValues(host *domainhost.Host) ColumnValues {
+	columnValues := make(ColumnValues, len(host.Metrics.CustomMetrics))
+
+	for idx := range host.Metrics.CustomMetrics {
+		customMetric := &host.Metrics.CustomMetrics[idx]
+
+		columnValues[customMetric.Column] = customMetric.FormatValue()
+	}
+
+	return columnValues
+}
+
+// customRows splits the columns of the custom metrics shown on the dashboard into rows.
+func customRows(customMetrics domainmetrics.CustomMetrics) [][]enums.ColumnName {
+	var (
+		rows [][]enums.ColumnName
+		row  []enums.ColumnName
+	)
+
+	for _, customMetric := range dashboardCustomMetrics(customMetrics) {
+		row = append(row, customMetric.Column)
+		if len(row) == customCellsPerRow {
+			rows = append(rows, row)
+			row = nil
+		}
+	}
+
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// dashboardCustomMetrics returns the custom metrics requested to be shown on the dashboard.
+func dashboardCustomMetrics(customMetrics domainmetrics.CustomMetrics) domainmetrics.CustomMetrics {
+	var result domainmetrics.CustomMetrics
+
+	for _, customMetric := range customMetrics {
+		if customMetric.Dashboard {
+			result = append(result, customMetric)
+		}
+	}
+
+	return result
+}
@@ -14,9 +14,10 @@ var (
 		// Overview section
 		enums.ColumnNameCurrentEpoch:          ColumnWidth33,
 		enums.ColumnNameNetworkPeers:          ColumnWidth15,
-		enums.ColumnNameUptime:                ColumnWidth25,
-		enums.ColumnNameVersion:               ColumnWidth25,
-		enums.ColumnNameCommit:                ColumnWidth25,
+		enums.ColumnNameUptime:                ColumnWidth20,
+		enums.ColumnNameVersion:               ColumnWidth20,
+		enums.ColumnNameCommit:                ColumnWidth20,
+		enums.ColumnNameLatency:               ColumnWidth24,
 		enums.ColumnNameCheckpointExecBacklog: ColumnWidth33,
 		enums.ColumnNameCheckpointSyncBacklog: ColumnWidth33,
 
@@ -44,6 +45,7 @@ var (
 				enums.ColumnNameUptime,
 				enums.ColumnNameVersion,
 				enums.ColumnNameCommit,
+				enums.ColumnNameLatency,
 			},
 		},
 		1: {
@@ -92,6 +94,7 @@ var (
 		enums.ColumnNameUptime:                       {"UPTIME", cell.ColorGreen},
 		enums.ColumnNameVersion:                      {"VERSION", cell.ColorGreen},
 		enums.ColumnNameCommit:                       {"COMMIT", cell.ColorGreen},
+		enums.ColumnNameLatency:                      {"LATENCY, MS", cell.ColorGreen},
 		enums.ColumnNameCurrentEpoch:                 {"CURRENT EPOCH", cell.ColorGreen},
 		enums.ColumnNameCheckpointExecBacklog:        {"CHECKPOINT EXEC BACKLOG", cell.ColorGreen},
 		enums.ColumnNameCheckpointSyncBacklog:        {"CHECKPOINT SYNC BACKLOG", cell.ColorGreen},
@@ -115,22 +118,23 @@ var (
 func GetNodeColumnValues(host *domainhost.Host) (ColumnValues, error) {
 	return ColumnValues{
 		enums.ColumnNameTotalTransactionBlocks:       host.Metrics.TotalTransactionsBlocks,
-		enums.ColumnNameTotalTransactionCertificates: host.Metrics.TotalTransactionCertificates,
-		enums.ColumnNameTotalTransactionEffects:      host.Metrics.TotalTransactionEffects,
+		enums.ColumnNameTotalTransactionCertificates: host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificates, enums.MetricTypeTotalTransactionCertificates),
+		enums.ColumnNameTotalTransactionEffects:      host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionEffects, enums.MetricTypeTotalTransactionEffects),
 		enums.ColumnNameTransactionsPerSecond:        host.Metrics.TransactionsPerSecond,
 		enums.ColumnNameLatestCheckpoint:             host.Metrics.LatestCheckpoint,
-		enums.ColumnNameHighestKnownCheckpoint:       host.Metrics.HighestKnownCheckpoint,
-		enums.ColumnNameHighestSyncedCheckpoint:      host.Metrics.HighestSyncedCheckpoint,
-		enums.ColumnNameLastExecutedCheckpoint:       host.Metrics.LastExecutedCheckpoint,
-		enums.ColumnNameCheckpointExecBacklog:        host.Metrics.CheckpointExecBacklog,
-		enums.ColumnNameCheckpointSyncBacklog:        host.Metrics.CheckpointSyncBacklog,
-		enums.ColumnNameCurrentEpoch:                 host.Metrics.CurrentEpoch,
+		enums.ColumnNameHighestKnownCheckpoint:       host.Metrics.ValueOrNotAvailable(host.Metrics.HighestKnownCheckpoint, enums.MetricTypeHighestKnownCheckpoint),
+		enums.ColumnNameHighestSyncedCheckpoint:      host.Metrics.ValueOrNotAvailable(host.Metrics.HighestSyncedCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameLastExecutedCheckpoint:       host.Metrics.ValueOrNotAvailable(host.Metrics.LastExecutedCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointExecBacklog:        host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointExecBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointSyncBacklog:        host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointSyncBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameCurrentEpoch:                 host.Metrics.ValueOrNotAvailable(host.Metrics.CurrentEpoch, enums.MetricTypeCurrentEpoch),
 		enums.ColumnNameTXSyncPercentage:             fmt.Sprintf("%v%%", host.Metrics.TxSyncPercentage),
 		enums.ColumnNameCheckSyncPercentage:          fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
 		enums.ColumnNameCheckpointsPerSecond:         host.Metrics.CheckpointsPerSecond,
-		enums.ColumnNameNetworkPeers:                 host.Metrics.NetworkPeers,
-		enums.ColumnNameUptime:                       host.Metrics.Uptime,
-		enums.ColumnNameVersion:                      host.Metrics.Version,
-		enums.ColumnNameCommit:                       host.Metrics.Commit,
+		enums.ColumnNameNetworkPeers:                 host.Metrics.ValueOrNotAvailable(host.Metrics.NetworkPeers, enums.MetricTypeSuiNetworkPeers),
+		enums.ColumnNameUptime:                       host.Metrics.ValueOrNotAvailable(host.Metrics.Uptime, enums.MetricTypeUptime),
+		enums.ColumnNameVersion:                      host.Metrics.ValueOrNotAvailable(host.Metrics.Version, enums.MetricTypeUptime),
+		enums.ColumnNameCommit:                       host.Metrics.ValueOrNotAvailable(host.Metrics.Commit, enums.MetricTypeUptime),
+		enums.ColumnNameLatency:                      host.GetLatencyMs(),
 	}, nil
 }
@@ -13,6 +13,7 @@ var (
 		enums.ColumnNameSystemTimeTillNextEpoch: ColumnWidth19,
 		enums.ColumnNameTotalTransactionBlocks:  ColumnWidth30,
 		enums.ColumnNameLatestCheckpoint:        ColumnWidth30,
+		enums.ColumnNameLatency:                 ColumnWidth98,
 	}
 
 	RowsConfigRPC = RowsConfig{
@@ -25,6 +26,12 @@ var (
 				enums.ColumnNameLatestCheckpoint,
 			},
 		},
+		1: {
+			Height: RowHeight14,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameLatency,
+			},
+		},
 	}
 
 	CellsConfigRPC = CellsConfig{
@@ -32,6 +39,7 @@ var (
 		enums.ColumnNameSystemTimeTillNextEpoch: {"TIME TILL NEXT EPOCH", cell.ColorGreen},
 		enums.ColumnNameTotalTransactionBlocks:  {"TOTAL TRANSACTION BLOCKS", cell.ColorYellow},
 		enums.ColumnNameLatestCheckpoint:        {"LATEST CHECKPOINT", cell.ColorBlue},
+		enums.ColumnNameLatency:                 {"LATENCY, MS", cell.ColorGreen},
 	}
 )
 
@@ -44,5 +52,6 @@ func GetRPCColumnValues(host *domainhost.Host) (ColumnValues, error) {
 		enums.ColumnNameLatestCheckpoint:        host.Metrics.LatestCheckpoint,
 		enums.ColumnNameCurrentEpoch:            host.Metrics.SystemState.Epoch,
 		enums.ColumnNameSystemTimeTillNextEpoch: host.Metrics.DurationTillEpochEndHHMM,
+		enums.ColumnNameLatency:                 host.GetLatencyMs(),
 	}, nil
 }
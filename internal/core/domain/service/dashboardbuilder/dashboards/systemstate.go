@@ -17,8 +17,10 @@ const (
 	ColumnWidth30 = 30
 	ColumnWidth33 = 33
 	ColumnWidth49 = 49
+	ColumnWidth98 = 98
 	ColumnWidth99 = 20
 	ColumnWidth19 = 19
+	RowHeight12   = 12
 	RowHeight14   = 14
 )
 
@@ -37,18 +37,24 @@ var (
 		enums.ColumnNameRoundsPerSecond:                      ColumnWidth49,
 
 		// Peers section
-		enums.ColumnNameNetworkPeers:        ColumnWidth19,
-		enums.ColumnNamePrimaryNetworkPeers: ColumnWidth19,
-		enums.ColumnNameWorkerNetworkPeers:  ColumnWidth19,
+		enums.ColumnNameNetworkPeers:        ColumnWidth14,
+		enums.ColumnNamePrimaryNetworkPeers: ColumnWidth14,
+		enums.ColumnNameWorkerNetworkPeers:  ColumnWidth14,
 
 		// Performance section
-		enums.ColumnNameSkippedConsensusTransactions: ColumnWidth19,
-		enums.ColumnNameTotalSignatureErrors:         ColumnWidth19,
+		enums.ColumnNameSkippedConsensusTransactions: ColumnWidth14,
+		enums.ColumnNameSkippedConsensusTxPerMinute:  ColumnWidth14,
+		enums.ColumnNameTotalSignatureErrors:         ColumnWidth14,
+		enums.ColumnNameSignatureErrorsPerMinute:     ColumnWidth14,
+
+		// Latency section
+		enums.ColumnNameCertificateNonConsensusLatency: ColumnWidth49,
+		enums.ColumnNameCertificateConsensusLatency:    ColumnWidth49,
 	}
 
 	RowsConfigValidator = RowsConfig{
 		0: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameCurrentEpoch,
 				enums.ColumnNameUptime,
@@ -57,17 +63,19 @@ var (
 			},
 		},
 		1: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameNetworkPeers,
 				enums.ColumnNamePrimaryNetworkPeers,
 				enums.ColumnNameWorkerNetworkPeers,
 				enums.ColumnNameSkippedConsensusTransactions,
+				enums.ColumnNameSkippedConsensusTxPerMinute,
 				enums.ColumnNameTotalSignatureErrors,
+				enums.ColumnNameSignatureErrorsPerMinute,
 			},
 		},
 		2: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameLastExecutedCheckpoint,
 				enums.ColumnNameHighestKnownCheckpoint,
@@ -75,14 +83,14 @@ var (
 			},
 		},
 		3: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameCheckSyncPercentage,
 				enums.ColumnNameCheckpointsPerSecond,
 			},
 		},
 		4: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameTotalTransactionCertificates,
 				enums.ColumnNameTotalTransactionEffects,
@@ -90,7 +98,7 @@ var (
 			},
 		},
 		5: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameLastCommittedLeaderRound,
 				enums.ColumnNameHighestAcceptedRound,
@@ -98,40 +106,50 @@ var (
 			},
 		},
 		6: {
-			Height: ColumnWidth14,
+			Height: RowHeight12,
 			Columns: []enums.ColumnName{
 				enums.ColumnNameCertificatesPerSecond,
 				enums.ColumnNameRoundsPerSecond,
 			},
 		},
+		7: {
+			Height: RowHeight12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameCertificateNonConsensusLatency,
+				enums.ColumnNameCertificateConsensusLatency,
+			},
+		},
 	}
 
 	CellsConfigValidator = CellsConfig{
-		enums.ColumnNameCurrentEpoch:                            {"CURRENT EPOCH", cell.ColorGreen},
-		enums.ColumnNameUptime:                                  {"UPTIME", cell.ColorGreen},
-		enums.ColumnNameVersion:                                 {"VERSION", cell.ColorGreen},
-		enums.ColumnNameCommit:                                  {"COMMIT", cell.ColorGreen},
-		enums.ColumnNameNetworkPeers:                            {"SUI NETWORK PEERS", cell.ColorGreen},
-		enums.ColumnNamePrimaryNetworkPeers:                     {"PRIMARY NETWORK PEERS", cell.ColorGreen},
-		enums.ColumnNameWorkerNetworkPeers:                      {"WORKER NETWORK PEERS", cell.ColorGreen},
-		enums.ColumnNameSkippedConsensusTransactions:            {"SKIPPED CONSENSUS TRANSACTIONS", cell.ColorGreen},
-		enums.ColumnNameTotalSignatureErrors:                    {"TOTAL SIGNATURE ERRORS", cell.ColorGreen},
-		enums.ColumnNameHighestKnownCheckpoint:                  {"HIGHEST KNOWN CHECKPOINT", cell.ColorBlue},
-		enums.ColumnNameHighestSyncedCheckpoint:                 {"HIGHEST SYNCED CHECKPOINT", cell.ColorBlue},
-		enums.ColumnNameLastExecutedCheckpoint:                  {"LAST EXECUTED CHECKPOINT", cell.ColorBlue},
-		enums.ColumnNameCheckpointExecBacklog:                   {"CHECKPOINT EXEC BACKLOG", cell.ColorBlue},
-		enums.ColumnNameCheckpointSyncBacklog:                   {"CHECKPOINT SYNC BACKLOG", cell.ColorBlue},
-		enums.ColumnNameCheckSyncPercentage:                     {"CHECKPOINTS SYNC PERCENTAGE", cell.ColorBlue},
-		enums.ColumnNameCheckpointsPerSecond:                    {"CHECKPOINTS VOLUME", cell.ColorBlue},
-		enums.ColumnNameTotalTransactionCertificates:            {"TOTAL TRANSACTION CERTIFICATES", cell.ColorYellow},
-		enums.ColumnNameTotalTransactionEffects:                 {"TOTAL TRANSACTION EFFECTS", cell.ColorYellow},
-		enums.ColumnNameTotalTransactionCertificatesCreated:     {"CERTIFICATES CREATED", cell.ColorYellow},
-		enums.ColumnNameLastCommittedLeaderRound:                {"LAST COMMITTED LEADER ROUND", cell.ColorRed},
-		enums.ColumnNameHighestAcceptedRound:                    {"HIGHEST ACCEPTED ROUND", cell.ColorRed},
-		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:    {"CONSENSUS ROUND PROBER CURRENT ROUND GAPS", cell.ColorRed},
-		enums.ColumnNameRoundsPerSecond:                         {"ROUNDS RATIO", cell.ColorRed},
-		enums.ColumnNameCertificatesPerSecond:                   {"CERTIFICATES RATIO", cell.ColorYellow},
-		enums.ColumnNameHandleCertificateNonConsensusLatencySum: {"CERTIFICATE NON CONSENSUS LATENCY", cell.ColorRed},
+		enums.ColumnNameCurrentEpoch:                         {"CURRENT EPOCH", cell.ColorGreen},
+		enums.ColumnNameUptime:                               {"UPTIME", cell.ColorGreen},
+		enums.ColumnNameVersion:                              {"VERSION", cell.ColorGreen},
+		enums.ColumnNameCommit:                               {"COMMIT", cell.ColorGreen},
+		enums.ColumnNameNetworkPeers:                         {"SUI NETWORK PEERS", cell.ColorGreen},
+		enums.ColumnNamePrimaryNetworkPeers:                  {"PRIMARY NETWORK PEERS", cell.ColorGreen},
+		enums.ColumnNameWorkerNetworkPeers:                   {"WORKER NETWORK PEERS", cell.ColorGreen},
+		enums.ColumnNameSkippedConsensusTransactions:         {"SKIPPED CONSENSUS TRANSACTIONS", cell.ColorGreen},
+		enums.ColumnNameTotalSignatureErrors:                 {"TOTAL SIGNATURE ERRORS", cell.ColorGreen},
+		enums.ColumnNameSkippedConsensusTxPerMinute:          {"SKIPPED CONSENSUS TX PER MIN", cell.ColorGreen},
+		enums.ColumnNameSignatureErrorsPerMinute:             {"SIGNATURE ERRORS PER MIN", cell.ColorGreen},
+		enums.ColumnNameHighestKnownCheckpoint:               {"HIGHEST KNOWN CHECKPOINT", cell.ColorBlue},
+		enums.ColumnNameHighestSyncedCheckpoint:              {"HIGHEST SYNCED CHECKPOINT", cell.ColorBlue},
+		enums.ColumnNameLastExecutedCheckpoint:               {"LAST EXECUTED CHECKPOINT", cell.ColorBlue},
+		enums.ColumnNameCheckpointExecBacklog:                {"CHECKPOINT EXEC BACKLOG", cell.ColorBlue},
+		enums.ColumnNameCheckpointSyncBacklog:                {"CHECKPOINT SYNC BACKLOG", cell.ColorBlue},
+		enums.ColumnNameCheckSyncPercentage:                  {"CHECKPOINTS SYNC PERCENTAGE", cell.ColorBlue},
+		enums.ColumnNameCheckpointsPerSecond:                 {"CHECKPOINTS VOLUME", cell.ColorBlue},
+		enums.ColumnNameTotalTransactionCertificates:         {"TOTAL TRANSACTION CERTIFICATES", cell.ColorYellow},
+		enums.ColumnNameTotalTransactionEffects:              {"TOTAL TRANSACTION EFFECTS", cell.ColorYellow},
+		enums.ColumnNameTotalTransactionCertificatesCreated:  {"CERTIFICATES CREATED", cell.ColorYellow},
+		enums.ColumnNameLastCommittedLeaderRound:             {"LAST COMMITTED LEADER ROUND", cell.ColorRed},
+		enums.ColumnNameHighestAcceptedRound:                 {"HIGHEST ACCEPTED ROUND", cell.ColorRed},
+		enums.ColumnNameConsensusRoundProberCurrentRoundGaps: {"CONSENSUS ROUND PROBER CURRENT ROUND GAPS", cell.ColorRed},
+		enums.ColumnNameRoundsPerSecond:                      {"ROUNDS RATIO", cell.ColorRed},
+		enums.ColumnNameCertificatesPerSecond:                {"CERTIFICATES RATIO", cell.ColorYellow},
+		enums.ColumnNameCertificateNonConsensusLatency:       {"CERTIFICATE NON CONSENSUS LATENCY P50 / P95 / P99 / AVG, MS", cell.ColorRed},
+		enums.ColumnNameCertificateConsensusLatency:          {"CERTIFICATE CONSENSUS LATENCY P50 / P95 / P99 / AVG, MS", cell.ColorRed},
 	}
 )
 
@@ -140,28 +158,31 @@ var (
 // The function also includes emoji values in the map if the specified flag is true.
 func GetValidatorColumnValues(host *domainhost.Host) (ColumnValues, error) {
 	return ColumnValues{
-		enums.ColumnNameTotalTransactionCertificates:            host.Metrics.TotalTransactionCertificates,
-		enums.ColumnNameTotalTransactionEffects:                 host.Metrics.TotalTransactionEffects,
-		enums.ColumnNameHighestKnownCheckpoint:                  host.Metrics.HighestKnownCheckpoint,
-		enums.ColumnNameHighestSyncedCheckpoint:                 host.Metrics.HighestSyncedCheckpoint,
-		enums.ColumnNameLastExecutedCheckpoint:                  host.Metrics.LastExecutedCheckpoint,
-		enums.ColumnNameCheckpointExecBacklog:                   host.Metrics.CheckpointExecBacklog,
-		enums.ColumnNameCheckpointSyncBacklog:                   host.Metrics.CheckpointSyncBacklog,
-		enums.ColumnNameCurrentEpoch:                            host.Metrics.CurrentEpoch,
-		enums.ColumnNameCheckSyncPercentage:                     fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
-		enums.ColumnNameCheckpointsPerSecond:                    host.Metrics.CheckpointsPerSecond,
-		enums.ColumnNameNetworkPeers:                            host.Metrics.NetworkPeers,
-		enums.ColumnNameUptime:                                  host.Metrics.Uptime,
-		enums.ColumnNameVersion:                                 host.Metrics.Version,
-		enums.ColumnNameCommit:                                  host.Metrics.Commit,
-		enums.ColumnNameLastCommittedLeaderRound:                host.Metrics.LastCommittedLeaderRound,
-		enums.ColumnNameHighestAcceptedRound:                    host.Metrics.HighestAcceptedRound,
-		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:    host.Metrics.ConsensusRoundProberCurrentRoundGaps,
-		enums.ColumnNameRoundsPerSecond:                         host.Metrics.RoundsPerSecond,
-		enums.ColumnNameSkippedConsensusTransactions:            host.Metrics.SkippedConsensusTransactions,
-		enums.ColumnNameTotalSignatureErrors:                    host.Metrics.TotalSignatureErrors,
-		enums.ColumnNameTotalTransactionCertificatesCreated:     host.Metrics.TotalTransactionCertificatesCreated,
-		enums.ColumnNameCertificatesPerSecond:                   host.Metrics.CertificatesPerSecond,
-		enums.ColumnNameHandleCertificateNonConsensusLatencySum: host.Metrics.NonConsensusLatency,
+		enums.ColumnNameTotalTransactionCertificates:         host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificates, enums.MetricTypeTotalTransactionCertificates),
+		enums.ColumnNameTotalTransactionEffects:              host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionEffects, enums.MetricTypeTotalTransactionEffects),
+		enums.ColumnNameHighestKnownCheckpoint:               host.Metrics.ValueOrNotAvailable(host.Metrics.HighestKnownCheckpoint, enums.MetricTypeHighestKnownCheckpoint),
+		enums.ColumnNameHighestSyncedCheckpoint:              host.Metrics.ValueOrNotAvailable(host.Metrics.HighestSyncedCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameLastExecutedCheckpoint:               host.Metrics.ValueOrNotAvailable(host.Metrics.LastExecutedCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointExecBacklog:                host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointExecBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointSyncBacklog:                host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointSyncBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameCurrentEpoch:                         host.Metrics.ValueOrNotAvailable(host.Metrics.CurrentEpoch, enums.MetricTypeCurrentEpoch),
+		enums.ColumnNameCheckSyncPercentage:                  fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
+		enums.ColumnNameCheckpointsPerSecond:                 host.Metrics.CheckpointsPerSecond,
+		enums.ColumnNameNetworkPeers:                         host.Metrics.ValueOrNotAvailable(host.Metrics.NetworkPeers, enums.MetricTypeSuiNetworkPeers),
+		enums.ColumnNameUptime:                               host.Metrics.ValueOrNotAvailable(host.Metrics.Uptime, enums.MetricTypeUptime),
+		enums.ColumnNameVersion:                              host.Metrics.ValueOrNotAvailable(host.Metrics.Version, enums.MetricTypeUptime),
+		enums.ColumnNameCommit:                               host.Metrics.ValueOrNotAvailable(host.Metrics.Commit, enums.MetricTypeUptime),
+		enums.ColumnNameLastCommittedLeaderRound:             host.Metrics.ValueOrNotAvailable(host.Metrics.LastCommittedLeaderRound, enums.MetricTypeConsensusLastCommittedLeaderRound),
+		enums.ColumnNameHighestAcceptedRound:                 host.Metrics.ValueOrNotAvailable(host.Metrics.HighestAcceptedRound, enums.MetricTypeConsensusHighestAcceptedRound),
+		enums.ColumnNameConsensusRoundProberCurrentRoundGaps: host.Metrics.ValueOrNotAvailable(host.Metrics.ConsensusRoundProberCurrentRoundGaps, enums.MetricTypeConsensusRoundProberCurrentRoundGaps),
+		enums.ColumnNameRoundsPerSecond:                      host.Metrics.RoundsPerSecond,
+		enums.ColumnNameSkippedConsensusTransactions:         host.Metrics.ValueOrNotAvailable(host.Metrics.SkippedConsensusTransactions, enums.MetricTypeSkippedConsensusTransactions),
+		enums.ColumnNameTotalSignatureErrors:                 host.Metrics.ValueOrNotAvailable(host.Metrics.TotalSignatureErrors, enums.MetricTypeTotalSignatureErrors),
+		enums.ColumnNameSkippedConsensusTxPerMinute:          host.Metrics.ValueOrNotAvailable(host.Metrics.Rate(enums.MetricTypeSkippedConsensusTransactions).FormatPerMinute(), enums.MetricTypeSkippedConsensusTransactions),
+		enums.ColumnNameSignatureErrorsPerMinute:             host.Metrics.ValueOrNotAvailable(host.Metrics.Rate(enums.MetricTypeTotalSignatureErrors).FormatPerMinute(), enums.MetricTypeTotalSignatureErrors),
+		enums.ColumnNameTotalTransactionCertificatesCreated:  host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificatesCreated, enums.MetricTypeTotalTransactionCertificatesCreated),
+		enums.ColumnNameCertificatesPerSecond:                host.Metrics.CertificatesPerSecond,
+		enums.ColumnNameCertificateNonConsensusLatency:       host.Metrics.ValueOrNotAvailable(host.Metrics.CertificateNonConsensusLatency.Stats().Milliseconds(), enums.MetricTypeCertificateNonConsensusLatency),
+		enums.ColumnNameCertificateConsensusLatency:          host.Metrics.ValueOrNotAvailable(host.Metrics.CertificateConsensusLatency.Stats().Milliseconds(), enums.MetricTypeCertificateConsensusLatency),
 	}, nil
 }
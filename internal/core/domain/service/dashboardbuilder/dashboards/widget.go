@@ -73,7 +73,8 @@ func newWidgetByColumnName(columnName enums.ColumnName, color cell.Color) (widge
 		}
 
 		return widget, nil
-	case enums.ColumnNameCheckpointsPerSecond, enums.ColumnNameTransactionsPerSecond, enums.ColumnNameRoundsPerSecond, enums.ColumnNameCertificatesPerSecond:
+	case enums.ColumnNameCheckpointsPerSecond, enums.ColumnNameTransactionsPerSecond, enums.ColumnNameRoundsPerSecond, enums.ColumnNameCertificatesPerSecond,
+		enums.ColumnNameLatency:
 		widget, err := newWidgetOfType(enums.WidgetTypeSparkLine, color)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize text widget for %s: %w", columnName, err)
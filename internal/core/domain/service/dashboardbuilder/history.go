@@ -0,0 +1,73 @@
+package dashboardbuilder
+
+import (
+	"math"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	// historyPrefillWindow is how far back the samples used to prefill the sparklines are taken from.
+	historyPrefillWindow = time.Hour
+
+	// historyRecordInterval is the minimum time between the samples recorded while the dashboard is running,
+	// so the history is not written on every query.
+	historyRecordInterval = 10 * time.Second
+)
+
+// sparklineHistoryMetrics maps the sparkline columns to the metrics recorded in the history they are prefilled with.
+var sparklineHistoryMetrics = map[enums.ColumnName]enums.MetricType{
+	enums.ColumnNameCheckpointsPerSecond:  enums.MetricTypeCheckpointsPerSecond,
+	enums.ColumnNameTransactionsPerSecond: enums.MetricTypeTransactionsPerSecond,
+	enums.ColumnNameRoundsPerSecond:       enums.MetricTypeRoundsPerSecond,
+	enums.ColumnNameCertificatesPerSecond: enums.MetricTypeCertificatesPerSecond,
+	enums.ColumnNameLatency:               enums.MetricTypeLatency,
+}
+
+// prefillSparklines adds the values recorded in the metrics history for the host to the sparklines,
+// so the trends are shown right after the dashboard is started. The history is optional, so the
+// dashboard is started with empty sparklines if it is not available or cannot be read.
+func (db *Builder) prefillSparklines() {
+	if db.historyGateway == nil {
+		return
+	}
+
+	samples, err := db.historyGateway.Samples(db.historyKey, time.Now().Add(-historyPrefillWindow))
+	if err != nil || len(samples) == 0 {
+		return
+	}
+
+	for columnName, cell := range db.cells {
+		metricType, ok := sparklineHistoryMetrics[columnName]
+		if !ok {
+			continue
+		}
+
+		values := make([]int, 0, len(samples))
+
+		for _, sample := range samples {
+			if value, ok := sample.Values[metricType]; ok {
+				values = append(values, int(math.Round(value)))
+			}
+		}
+
+		_ = cell.Prefill(values)
+	}
+}
+
+// recordHistory records the sample of the host polled by the dashboard in the metrics history, at most once per
+// historyRecordInterval. The history is best effort, so the errors are ignored, as they cannot be shown over the dashboard.
+func (db *Builder) recordHistory(now time.Time) {
+	if db.historyGateway == nil || now.Sub(db.lastRecorded) < historyRecordInterval {
+		return
+	}
+
+	db.lastRecorded = now
+
+	_ = db.historyGateway.Record([]ports.HistoryRecord{{
+		Key:    db.historyKey,
+		Sample: db.host.GetHistorySample(now),
+	}})
+}
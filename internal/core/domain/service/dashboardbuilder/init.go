@@ -58,16 +58,24 @@ func (db *Builder) setupDashboard() error {
 		return err
 	}
 
-	return db.createDashboard(options)
+	if err := db.createDashboard(options); err != nil {
+		return err
+	}
+
+	db.prefillSparklines()
+
+	return nil
 }
 
-// loadCells fetches the cells configuration and builds the cells.
+// loadCells fetches the cells configuration extended with the custom metrics and builds the cells.
 func (db *Builder) loadCells() (dashboards.Cells, error) {
 	cellsConfig, err := dashboards.GetCellsConfig(db.tableType)
 	if err != nil {
 		return nil, err
 	}
 
+	cellsConfig = dashboards.WithCustomCells(cellsConfig, db.host.Metrics.CustomMetrics)
+
 	cells, err := dashboards.GetCells(cellsConfig)
 	if err != nil {
 		return nil, err
@@ -76,13 +84,15 @@ func (db *Builder) loadCells() (dashboards.Cells, error) {
 	return cells, nil
 }
 
-// loadColumns fetches the columns configuration and builds the columns based on the cells.
+// loadColumns fetches the columns configuration extended with the custom metrics and builds the columns based on the cells.
 func (db *Builder) loadColumns(cells dashboards.Cells) (dashboards.Columns, error) {
 	columnsConfig, err := dashboards.GetColumnsConfig(db.tableType)
 	if err != nil {
 		return nil, err
 	}
 
+	columnsConfig = dashboards.WithCustomColumns(columnsConfig, db.host.Metrics.CustomMetrics)
+
 	columns, err := dashboards.GetColumns(columnsConfig, cells)
 	if err != nil {
 		return nil, err
@@ -91,13 +101,15 @@ func (db *Builder) loadColumns(cells dashboards.Cells) (dashboards.Columns, erro
 	return columns, nil
 }
 
-// loadRows fetches the rows configuration and builds the rows based on the columns.
+// loadRows fetches the rows configuration extended with the custom metrics and builds the rows based on the columns.
 func (db *Builder) loadRows(columns dashboards.Columns) ([]grid.Element, error) {
 	rowsConfig, err := dashboards.GetRowsConfig(db.tableType)
 	if err != nil {
 		return nil, err
 	}
 
+	rowsConfig = dashboards.WithCustomRows(rowsConfig, db.host.Metrics.CustomMetrics)
+
 	rows, err := dashboards.GetRows(rowsConfig, columns)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,8 @@
 package dashboardbuilder
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -17,29 +19,34 @@ const (
 )
 
 // Render renders the dashboard by starting the query and rerender loops,
-// and waiting for them to complete. It returns an error if any of the loops
-// encounter an error.
+// and waiting for them to complete. The loops stop when the dashboard is quit,
+// the parent context is canceled or any of the loops encounters an error.
+// The terminal is always restored before the function returns.
 func (db *Builder) Render() (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			db.cliGateway.Error(fmt.Sprintf("panic: %v", r))
 			db.tearDown()
+			db.cliGateway.Error(fmt.Sprintf("panic: %v", r))
 			os.Exit(1)
-		} else if err != nil {
-			db.tearDown()
 		}
+
+		db.tearDown()
 	}()
 
-	var errGroup errgroup.Group
+	errGroup, ctx := errgroup.WithContext(db.ctx)
 
 	queryTicker, renderTicker := startTickers()
 	defer stopTickers(queryTicker, renderTicker)
 
-	errGroup.Go(queryMetricsLoop(db, queryTicker))
-	errGroup.Go(rerenderLoop(db, renderTicker))
-	errGroup.Go(runDashboard(db))
+	errGroup.Go(queryMetricsLoop(ctx, db, queryTicker))
+	errGroup.Go(rerenderLoop(ctx, db, renderTicker))
+	errGroup.Go(runDashboard(ctx, db))
 
-	return errGroup.Wait()
+	if err = errGroup.Wait(); errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
 }
 
 func startTickers() (queryTicker, renderTicker *time.Ticker) {
@@ -58,19 +65,26 @@ func stopTickers(tickers ...*time.Ticker) {
 // queryMetricsLoop fetches the metrics from the host at regular intervals.
 // It uses the provided ticker to trigger the fetch and returns an error if
 // the fetch encounters an error or if the context is done.
+// The metrics fetched are recorded in the metrics history, if it is available.
 // It returns a function that can be used to start the loop.
 // The loop can be stopped by canceling the context.
 // The function signature is compatible with the errgroup.Group.Go method.
 // The loop stops when the context is done.
-func queryMetricsLoop(db *Builder, ticker *time.Ticker) func() error {
+func queryMetricsLoop(ctx context.Context, db *Builder, ticker *time.Ticker) func() error {
 	return func() error {
 		for {
 			select {
 			case <-ticker.C:
-				if err := db.host.GetMetrics(); err != nil {
+				if err := db.host.GetMetrics(ctx); err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+
 					return err
 				}
-			case <-db.ctx.Done():
+
+				db.recordHistory(time.Now())
+			case <-ctx.Done():
 				return nil
 			}
 		}
@@ -82,7 +96,7 @@ func queryMetricsLoop(db *Builder, ticker *time.Ticker) func() error {
 // The loop stops when the context is done.
 // The function signature is compatible with the errgroup.Group.Go method.
 // It returns a function that can be used to start the loop.
-func rerenderLoop(db *Builder, ticker *time.Ticker) func() error {
+func rerenderLoop(ctx context.Context, db *Builder, ticker *time.Ticker) func() error {
 	return func() error {
 		for {
 			select {
@@ -102,7 +116,7 @@ func rerenderLoop(db *Builder, ticker *time.Ticker) func() error {
 						return writeErr
 					}
 				}
-			case <-db.ctx.Done():
+			case <-ctx.Done():
 				return nil
 			}
 		}
@@ -117,8 +131,8 @@ func rerenderLoop(db *Builder, ticker *time.Ticker) func() error {
 // The returned error indicates any failure during the dashboard run.
 // The function signature is compatible with the errgroup.Group.Go method.
 // It returns a function that can be used to start the dashboard.
-func runDashboard(db *Builder) func() error {
+func runDashboard(ctx context.Context, db *Builder) func() error {
 	return func() error {
-		return termdash.Run(db.ctx, db.terminal, db.dashboard, termdash.KeyboardSubscriber(db.quitter))
+		return termdash.Run(ctx, db.terminal, db.dashboard, termdash.KeyboardSubscriber(db.quitter))
 	}
 }
@@ -3,6 +3,7 @@ package tablebuilder
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -18,13 +19,26 @@ import (
 const slashingPct50 = 50
 const slashingPct100 = 50
 
+// protocolPreviousValueColumn is the position of the previous version value in the rows of the Protocol table.
+const protocolPreviousValueColumn = 4
+
+// protocolChangeColumn is the position of the change kind in the rows of the Protocol Diff table.
+const protocolChangeColumn = 1
+
+// validatorSetChangeColumn is the position of the change kind in the rows of the Validator Set table.
+const validatorSetChangeColumn = 1
+
+// atRiskProjectionColumn is the position of the projection in the rows of the At Risk Projection table.
+const atRiskProjectionColumn = 1
+
 type Builder struct {
-	writer     table.Writer
-	cliGateway *cligw.Gateway
-	config     *tables.TableConfig
-	tableType  enums.TableType
-	hosts      []host.Host
-	Releases   []metrics.Release
+	writer         table.Writer
+	cliGateway     *cligw.Gateway
+	config         *tables.TableConfig
+	protocolFilter *regexp.Regexp
+	tableType      enums.TableType
+	hosts          []host.Host
+	Releases       []metrics.Release
 }
 
 // NewBuilder creates a new instance of the table builder, using the CLI gateway.
@@ -41,6 +55,11 @@ func NewBuilder(tableType enums.TableType, hosts []host.Host, releases []metrics
 	}
 }
 
+// SetProtocolFilter sets the filter of the feature flags and attributes shown in the Protocol table by name.
+func (tb *Builder) SetProtocolFilter(filter *regexp.Regexp) {
+	tb.protocolFilter = filter
+}
+
 // setColumns sets the column configurations for the table builder based on the configuration in the builder's table config.
 func (tb *Builder) setColumns() {
 	columnsConfig := make([]table.ColumnConfig, len(tb.config.Columns))
@@ -85,7 +104,7 @@ func (tb *Builder) setRows() error {
 				IsHeader:       false,
 				IsFooter:       true,
 				Length:         columnsPerRow,
-				AutoMerge:      true,
+				AutoMerge:      !tb.config.NoAutoMerge,
 				AutoMergeAlign: text.AlignCenter,
 			})
 
@@ -155,6 +174,7 @@ func (tb *Builder) setColors() {
 		fgBlack  = text.FgBlack
 		bgRed    = text.BgRed
 		bgYellow = text.BgYellow
+		bgGreen  = text.BgGreen
 	)
 
 	var painter = func() func(row table.Row) text.Colors {
@@ -183,6 +203,49 @@ func (tb *Builder) setColors() {
 				return valuesRowFgColor
 			}
 
+			if tb.tableType == enums.TableTypeProtocolDiff && len(row) > protocolChangeColumn {
+				switch row[protocolChangeColumn] {
+				case string(metrics.ProtocolChangeAdded):
+					return text.Colors{bgGreen, fgBlack}
+				case string(metrics.ProtocolChangeRemoved):
+					return text.Colors{bgRed, fgWhite}
+				case string(metrics.ProtocolChangeChanged):
+					return text.Colors{bgYellow, fgBlack}
+				}
+			}
+
+			if tb.tableType == enums.TableTypeValidatorSet && len(row) > validatorSetChangeColumn {
+				switch row[validatorSetChangeColumn] {
+				case string(metrics.ValidatorSetChangeJoined):
+					return text.Colors{bgGreen, fgBlack}
+				case string(metrics.ValidatorSetChangeLeft):
+					return text.Colors{bgRed, fgWhite}
+				case string(metrics.ValidatorSetChangePendingRemoval):
+					return text.Colors{bgYellow, fgBlack}
+				default:
+					return valuesRowFgColor
+				}
+			}
+
+			if tb.tableType == enums.TableTypeAtRiskProjection && len(row) > atRiskProjectionColumn {
+				switch row[atRiskProjectionColumn] {
+				case string(metrics.AtRiskProjectionRemovedVeryLowStake), string(metrics.AtRiskProjectionRemovedGraceOver):
+					return text.Colors{bgRed, fgWhite}
+				case string(metrics.AtRiskProjectionEnters), string(metrics.AtRiskProjectionRemains):
+					return text.Colors{bgYellow, fgBlack}
+				case string(metrics.AtRiskProjectionLeaves):
+					return text.Colors{bgGreen, fgBlack}
+				default:
+					return valuesRowFgColor
+				}
+			}
+
+			if tb.tableType == enums.TableTypeProtocol && len(row) > protocolPreviousValueColumn {
+				if previousValue, ok := row[protocolPreviousValueColumn].(string); ok && previousValue != "" {
+					return text.Colors{bgYellow, fgBlack}
+				}
+			}
+
 			for _, column := range row {
 				switch value := column.(type) {
 				case int, int16, int32, int64:
@@ -190,7 +253,7 @@ func (tb *Builder) setColors() {
 				case bool:
 					return valuesRowFgColor
 				case string:
-					if _, err := strconv.Atoi(value); err == nil {
+					if _, err := strconv.ParseFloat(value, 64); err == nil || value == metrics.NotAvailable {
 						return valuesRowFgColor
 					}
 				}
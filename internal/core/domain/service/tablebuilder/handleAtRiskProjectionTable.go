@@ -0,0 +1,30 @@
+package tablebuilder
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleAtRiskProjectionTable handles the configuration for the At Risk Projection table.
+// It adds a row for each validator projected to be removed, to enter, remain in or leave the at-risk list
+// at the end of the epoch, comparing its next epoch stake with the stake thresholds.
+func (tb *Builder) handleAtRiskProjectionTable(systemState *domainmetrics.SuiSystemState) error {
+	projections, err := systemState.GetAtRiskProjections()
+	if err != nil {
+		return err
+	}
+
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeAtRiskProjection)
+	tableConfig.NoAutoMerge = true
+
+	for idx, projection := range projections {
+		tableConfig.Columns.SetColumnValues(tables.GetAtRiskProjectionColumnValues(idx, projection))
+
+		tableConfig.RowsCount++
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
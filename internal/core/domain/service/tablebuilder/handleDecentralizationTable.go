@@ -0,0 +1,34 @@
+package tablebuilder
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleDecentralizationTable handles the configuration for the Decentralization table.
+// It groups the active validators by the country, ASN and provider of their locations and adds a row for each group.
+func (tb *Builder) handleDecentralizationTable(metrics *domainmetrics.Metrics) error {
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeDecentralization)
+
+	distributions, err := metrics.SystemState.ActiveValidators.GetLocationDistributions(metrics.ValidatorsLocations)
+	if err != nil {
+		return err
+	}
+
+	for _, distribution := range distributions {
+		distribution := distribution
+
+		for idx := range distribution.Groups {
+			columnValues := tables.GetDecentralizationColumnValues(idx, &distribution, &distribution.Groups[idx])
+
+			tableConfig.Columns.SetColumnValues(columnValues)
+
+			tableConfig.RowsCount++
+		}
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package tablebuilder
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleNextEpochChangesTable handles the configuration for the Next Epoch Changes table.
+// It adds a row for each active validator the gas price, commission rate or stake of which change at the end of the epoch,
+// or with a key or an address rotation pending, sorted by the impact of the change.
+func (tb *Builder) handleNextEpochChangesTable(systemState *domainmetrics.SuiSystemState) error {
+	changes, err := systemState.ActiveValidators.GetNextEpochChanges()
+	if err != nil {
+		return err
+	}
+
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeNextEpochChanges)
+	tableConfig.NoAutoMerge = true
+
+	for idx, change := range changes {
+		tableConfig.Columns.SetColumnValues(tables.GetNextEpochChangesColumnValues(idx, change))
+
+		tableConfig.RowsCount++
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package tablebuilder
+
+import (
+	"fmt"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleProtocolDiffTable handles the configuration for the Protocol Diff table.
+// It adds a row for each feature flag and attribute added, removed or changed between the previous protocol config
+// and the current one, matching the protocol filter. The compared versions are shown in the title.
+func (tb *Builder) handleProtocolDiffTable(metrics *domainmetrics.Metrics) error {
+	if metrics.PreviousProtocol == nil {
+		return fmt.Errorf("no protocol config to compare version %s with", metrics.Protocol.ProtocolVersion)
+	}
+
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeProtocolDiff)
+	tableConfig.NoAutoMerge = true
+
+	tableConfig.Name = fmt.Sprintf("%s FROM VERSION %s TO VERSION %s", tableConfig.Name, metrics.PreviousProtocol.ProtocolVersion, metrics.Protocol.ProtocolVersion)
+
+	for idx, entry := range metrics.Protocol.GetChanges(metrics.PreviousProtocol, tb.protocolFilter) {
+		tableConfig.Columns.SetColumnValues(tables.GetProtocolDiffColumnValues(idx, entry))
+
+		tableConfig.RowsCount++
+	}
+
+	if tableConfig.RowsCount == 0 {
+		return fmt.Errorf("no protocol config changes between versions %s and %s", metrics.PreviousProtocol.ProtocolVersion, metrics.Protocol.ProtocolVersion)
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -1,23 +1,37 @@
 package tablebuilder
 
 import (
+	"fmt"
+
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
 	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
 )
 
 // handleProtocolTable handles the configuration for the Protocol table.
+// It adds a row for each feature flag and attribute of the protocol config matching the protocol filter,
+// with the value in the previous protocol version set for the changed ones. The versions are shown in the title.
 func (tb *Builder) handleProtocolTable(metrics *domainmetrics.Metrics) error {
 	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeProtocol)
+	tableConfig.NoAutoMerge = true
+
+	protocol := metrics.Protocol
+
+	tableConfig.Name = fmt.Sprintf("%s VERSION %s", tableConfig.Name, protocol.ProtocolVersion)
 
-	columnValues, err := tables.GetProtocolColumnValues(metrics)
-	if err != nil {
-		return err
+	if protocol.HasSupportedVersions() {
+		tableConfig.Name = fmt.Sprintf("%s, SUPPORTED %s - %s", tableConfig.Name, protocol.MinSupportedProtocolVersion, protocol.MaxSupportedProtocolVersion)
 	}
 
-	tableConfig.Columns.SetColumnValues(columnValues)
+	for idx, entry := range protocol.GetEntries(metrics.PreviousProtocol, tb.protocolFilter) {
+		tableConfig.Columns.SetColumnValues(tables.GetProtocolColumnValues(idx, entry))
 
-	tableConfig.RowsCount++
+		tableConfig.RowsCount++
+	}
+
+	if tableConfig.RowsCount == 0 {
+		return fmt.Errorf("no protocol config entries matching %s", tb.protocolFilter)
+	}
 
 	tb.config = tableConfig
 
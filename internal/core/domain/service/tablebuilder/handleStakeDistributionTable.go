@@ -0,0 +1,29 @@
+package tablebuilder
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleStakeDistributionTable handles the configuration for the Stake Distribution table.
+// It computes the stake distribution of the active validators for the current and the next epoch and adds a row for each figure.
+func (tb *Builder) handleStakeDistributionTable(systemState *domainmetrics.SuiSystemState) error {
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeStakeDistribution)
+	tableConfig.NoAutoMerge = true
+
+	distributions, err := systemState.ActiveValidators.GetStakeDistributions()
+	if err != nil {
+		return err
+	}
+
+	for _, columnValues := range tables.GetStakeDistributionColumnValues(distributions) {
+		tableConfig.Columns.SetColumnValues(columnValues)
+
+		tableConfig.RowsCount++
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package tablebuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleSubsidyForecastTable handles the configuration for the Subsidy Forecast table.
+// It adds a row for each subsidy period forecast, with the staking rewards estimated for the stake shown in the title.
+func (tb *Builder) handleSubsidyForecastTable(metrics *domainmetrics.Metrics) error {
+	forecast := metrics.SubsidyForecast
+	if forecast == nil {
+		return errors.New("subsidy forecast is not initialized")
+	}
+
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeSubsidyForecast)
+	tableConfig.NoAutoMerge = true
+
+	tableConfig.Name = fmt.Sprintf("%s FROM EPOCH %d, REWARDS FOR %s SUI STAKED", tableConfig.Name, forecast.Epoch,
+		strconv.FormatFloat(forecast.StakeSui, 'f', -1, 64))
+
+	for idx, period := range forecast.Periods {
+		tableConfig.Columns.SetColumnValues(tables.GetSubsidyForecastColumnValues(idx, forecast, period))
+
+		tableConfig.RowsCount++
+	}
+
+	if tableConfig.RowsCount == 0 {
+		return errors.New("no stake subsidy periods to forecast")
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package tablebuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+)
+
+// handleValidatorSetTable handles the configuration for the Validator Set table.
+// It adds a row for each validator joined or left since the epoch of the last snapshot, pending removal,
+// pending active and candidate. The current epoch and the epoch of the snapshot are shown in the title.
+func (tb *Builder) handleValidatorSetTable(metrics *domainmetrics.Metrics) error {
+	validatorSet := metrics.ValidatorSet
+	if validatorSet == nil {
+		return errors.New("validator set is not initialized")
+	}
+
+	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeValidatorSet)
+	tableConfig.NoAutoMerge = true
+
+	tableConfig.Name = fmt.Sprintf("%s EPOCH %s", tableConfig.Name, validatorSet.Epoch)
+
+	if validatorSet.SinceEpoch != "" {
+		tableConfig.Name = fmt.Sprintf("%s, CHANGES SINCE EPOCH %s", tableConfig.Name, validatorSet.SinceEpoch)
+	}
+
+	for idx, entry := range validatorSet.GetEntries() {
+		tableConfig.Columns.SetColumnValues(tables.GetValidatorSetColumnValues(idx, entry))
+
+		tableConfig.RowsCount++
+	}
+
+	tb.config = tableConfig
+
+	return nil
+}
@@ -13,6 +13,12 @@ import (
 func (tb *Builder) handleValidatorTable(hosts []domainhost.Host) error {
 	tableConfig := tables.NewDefaultTableConfig(enums.TableTypeValidator)
 
+	if len(hosts) > 0 {
+		if err := tableConfig.AddCustomColumns(hosts[0].Metrics.CustomMetrics); err != nil {
+			return err
+		}
+	}
+
 	sort.SliceStable(hosts, func(i, j int) bool {
 		left, right := hosts[i], hosts[j]
 		if left.Status != right.Status {
@@ -34,6 +40,9 @@ func (tb *Builder) handleValidatorTable(hosts []domainhost.Host) error {
 		}
 
 		columnValues := tables.GetValidatorColumnValues(idx, &host)
+		for column, value := range tables.GetCustomColumnValues(&host) {
+			columnValues[column] = value
+		}
 
 		tableConfig.Columns.SetColumnValues(columnValues)
 
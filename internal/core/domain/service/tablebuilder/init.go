@@ -26,6 +26,13 @@ func (tb *Builder) Init() error {
 		enums.TableTypeValidatorReports:   func() error { return tb.handleTableWithSystemState(tb.hosts, tb.handleValidatorReportsTable) },
 		enums.TableTypeActiveValidators:   func() error { return tb.handleTableWithMetrics(tb.hosts, tb.handleActiveValidatorsTable) },
 		enums.TableTypeReleases:           func() error { return tb.handleReleasesTable(tb.Releases) },
+		enums.TableTypeDecentralization:   func() error { return tb.handleTableWithMetrics(tb.hosts, tb.handleDecentralizationTable) },
+		enums.TableTypeStakeDistribution:  func() error { return tb.handleTableWithSystemState(tb.hosts, tb.handleStakeDistributionTable) },
+		enums.TableTypeProtocolDiff:       func() error { return tb.handleTableWithMetrics(tb.hosts, tb.handleProtocolDiffTable) },
+		enums.TableTypeNextEpochChanges:   func() error { return tb.handleTableWithSystemState(tb.hosts, tb.handleNextEpochChangesTable) },
+		enums.TableTypeAtRiskProjection:   func() error { return tb.handleTableWithSystemState(tb.hosts, tb.handleAtRiskProjectionTable) },
+		enums.TableTypeValidatorSet:       func() error { return tb.handleTableWithMetrics(tb.hosts, tb.handleValidatorSetTable) },
+		enums.TableTypeSubsidyForecast:    func() error { return tb.handleTableWithMetrics(tb.hosts, tb.handleSubsidyForecastTable) },
 	}
 
 	if handler, ok := handlerMap[tb.tableType]; ok {
@@ -0,0 +1,55 @@
+package tables
+
+import (
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigAtRiskProjection = ColumnsConfig{
+		enums.ColumnNameIndex:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAtRiskProjection:      NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAtRiskName:            NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameAtRiskAddress:         NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameAtRiskNextStake:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAtRiskShortfall:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAtRiskEpochs:          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAtRiskGraceEpochsLeft: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	}
+
+	RowsConfigAtRiskProjection = RowsConfig{
+		0: {
+			enums.ColumnNameIndex,
+			enums.ColumnNameAtRiskProjection,
+			enums.ColumnNameAtRiskName,
+			enums.ColumnNameAtRiskAddress,
+			enums.ColumnNameAtRiskNextStake,
+			enums.ColumnNameAtRiskShortfall,
+			enums.ColumnNameAtRiskEpochs,
+			enums.ColumnNameAtRiskGraceEpochsLeft,
+		},
+	}
+)
+
+// GetAtRiskProjectionColumnValues returns the column values for the at-risk status projected for the validator.
+// The grace epochs left are shown only for the validators that stay at risk.
+func GetAtRiskProjectionColumnValues(idx int, projection domainmetrics.ValidatorAtRiskProjection) ColumnValues {
+	var graceEpochsLeft any = ""
+
+	if projection.Projection == domainmetrics.AtRiskProjectionEnters || projection.Projection == domainmetrics.AtRiskProjectionRemains {
+		graceEpochsLeft = projection.GraceEpochsLeft
+	}
+
+	return ColumnValues{
+		enums.ColumnNameIndex:                 idx + 1,
+		enums.ColumnNameAtRiskProjection:      string(projection.Projection),
+		enums.ColumnNameAtRiskName:            projection.Name,
+		enums.ColumnNameAtRiskAddress:         projection.Address,
+		enums.ColumnNameAtRiskNextStake:       formatMistAsSui(projection.NextEpochStake),
+		enums.ColumnNameAtRiskShortfall:       projection.ShortfallSui,
+		enums.ColumnNameAtRiskEpochs:          projection.EpochsAtRisk,
+		enums.ColumnNameAtRiskGraceEpochsLeft: graceEpochsLeft,
+	}
+}
@@ -69,6 +69,13 @@ var columnsConfigMap = map[enums.TableType]ColumnsConfig{
 	enums.TableTypeActiveValidators:   ColumnsConfigActiveValidator,
 	enums.TableTypeReleases:           ColumnsConfigRelease,
 	enums.TableTypeProtocol:           ColumnsConfigProtocol,
+	enums.TableTypeDecentralization:   ColumnsConfigDecentralization,
+	enums.TableTypeStakeDistribution:  ColumnsConfigStakeDistribution,
+	enums.TableTypeProtocolDiff:       ColumnsConfigProtocolDiff,
+	enums.TableTypeValidatorSet:       ColumnsConfigValidatorSet,
+	enums.TableTypeAtRiskProjection:   ColumnsConfigAtRiskProjection,
+	enums.TableTypeNextEpochChanges:   ColumnsConfigNextEpochChanges,
+	enums.TableTypeSubsidyForecast:    ColumnsConfigSubsidyForecast,
 }
 
 // Define the mapping of TableType enums to their corresponding RowsConfig.
@@ -83,15 +90,29 @@ var rowsConfigMap = map[enums.TableType]RowsConfig{
 	enums.TableTypeActiveValidators:   RowsActiveValidator,
 	enums.TableTypeReleases:           RowsRelease,
 	enums.TableTypeProtocol:           RowsConfigProtocol,
+	enums.TableTypeDecentralization:   RowsDecentralization,
+	enums.TableTypeStakeDistribution:  RowsStakeDistribution,
+	enums.TableTypeProtocolDiff:       RowsConfigProtocolDiff,
+	enums.TableTypeValidatorSet:       RowsConfigValidatorSet,
+	enums.TableTypeAtRiskProjection:   RowsConfigAtRiskProjection,
+	enums.TableTypeNextEpochChanges:   RowsConfigNextEpochChanges,
+	enums.TableTypeSubsidyForecast:    RowsConfigSubsidyForecast,
 }
 
 // Define the mapping of TableType enums to their corresponding text.Colors.
 var tableColorMap = map[enums.TableType]text.Colors{
-	enums.TableTypeRPC:              {text.BgHiBlue, text.FgBlack},
-	enums.TableTypeValidator:        {text.BgHiBlue, text.FgBlack},
-	enums.TableTypeValidatorsAtRisk: {text.BgHiBlue, text.FgBlack},
-	enums.TableTypeActiveValidators: {text.BgHiBlue, text.FgBlack},
-	enums.TableTypeProtocol:         {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeRPC:               {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeValidator:         {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeValidatorsAtRisk:  {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeActiveValidators:  {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeProtocol:          {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeDecentralization:  {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeStakeDistribution: {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeProtocolDiff:      {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeValidatorSet:      {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeAtRiskProjection:  {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeNextEpochChanges:  {text.BgHiBlue, text.FgBlack},
+	enums.TableTypeSubsidyForecast:   {text.BgHiBlue, text.FgBlack},
 }
 
 // defaultTableColor defines the default color configuration.
@@ -111,6 +132,7 @@ type TableConfig struct {
 	Style        table.Style   // The style of the table
 	ColumnsCount int           // The total number of columns in the table
 	RowsCount    int           // The total number of rows in the table
+	NoAutoMerge  bool          // Whether the identical adjacent values of a row are kept in separate cells
 }
 
 // NewDefaultTableConfig returns a new default table configuration based on the specified table type.
@@ -0,0 +1,69 @@
+package tables
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// AddCustomColumns extends the table config with the columns of the custom metrics.
+// The columns are appended as new rows of the table, each holding as many columns as the first row.
+// The columns and rows configs are copied, so the default configs of the table stay unchanged.
+func (config *TableConfig) AddCustomColumns(customMetrics metrics.CustomMetrics) error {
+	if len(customMetrics) == 0 {
+		return nil
+	}
+
+	columns := make(ColumnsConfig, len(config.Columns)+len(customMetrics))
+	for name, column := range config.Columns {
+		columns[name] = column
+	}
+
+	rows := make(RowsConfig, len(config.Rows), len(config.Rows)+1)
+	copy(rows, config.Rows)
+
+	columnsPerRow := len(config.Rows[0])
+
+	var row []enums.ColumnName
+
+	for _, customMetric := range customMetrics {
+		if _, ok := columns[customMetric.Column]; ok {
+			return fmt.Errorf("custom metric %s conflicts with the column %q", customMetric.Name, customMetric.Column)
+		}
+
+		columns[customMetric.Column] = NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false)
+
+		row = append(row, customMetric.Column)
+		if len(row) == columnsPerRow {
+			rows = append(rows, row)
+			row = nil
+		}
+	}
+
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	config.Columns = columns
+	config.Rows = rows
+	config.ColumnsCount = len(columns)
+
+	return nil
+}
+
+// GetCustomColumnValues returns the formatted values of the custom metrics of the host keyed by their columns.
+func GetCustomColumnValues(host *domainhost.Host) ColumnValues {
+	columnValues := make(ColumnValues, len(host.Metrics.CustomMetrics))
+
+	for idx := range host.Metrics.CustomMetrics {
+		customMetric := &host.Metrics.CustomMetrics[idx]
+
+		columnValues[customMetric.Column] = customMetric.FormatValue()
+	}
+
+	return columnValues
+}
@@ -0,0 +1,52 @@
+package tables
+
+import (
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigDecentralization = ColumnsConfig{
+		enums.ColumnNameLocationDimension:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationNakamoto:        NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameIndex:                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationGroup:           NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameLocationValidators:      NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationStake:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationVotingPower:     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationShare:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLocationCumulativeShare: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	}
+	RowsDecentralization = RowsConfig{
+		0: {
+			enums.ColumnNameIndex,
+			enums.ColumnNameLocationDimension,
+			enums.ColumnNameLocationNakamoto,
+			enums.ColumnNameLocationGroup,
+			enums.ColumnNameLocationValidators,
+			enums.ColumnNameLocationStake,
+			enums.ColumnNameLocationVotingPower,
+			enums.ColumnNameLocationShare,
+			enums.ColumnNameLocationCumulativeShare,
+		},
+	}
+)
+
+// GetDecentralizationColumnValues returns a map of ColumnName keys to corresponding values for the specified group of validators.
+// The dimension and the Nakamoto coefficient of the distribution the group belongs to are repeated on each row of the distribution.
+// Returns a map of ColumnName keys to corresponding values.
+func GetDecentralizationColumnValues(idx int, distribution *domainmetrics.LocationDistribution, group *domainmetrics.LocationGroup) ColumnValues {
+	return ColumnValues{
+		enums.ColumnNameLocationDimension:       string(distribution.Dimension),
+		enums.ColumnNameLocationNakamoto:        distribution.Nakamoto,
+		enums.ColumnNameIndex:                   idx + 1,
+		enums.ColumnNameLocationGroup:           group.Name,
+		enums.ColumnNameLocationValidators:      group.Validators,
+		enums.ColumnNameLocationStake:           group.Stake.String(),
+		enums.ColumnNameLocationVotingPower:     group.VotingPower,
+		enums.ColumnNameLocationShare:           domainmetrics.FormatShare(group.Share),
+		enums.ColumnNameLocationCumulativeShare: domainmetrics.FormatShare(group.CumulativeShare),
+	}
+}
@@ -0,0 +1,86 @@
+package tables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigNextEpochChanges = ColumnsConfig{
+		enums.ColumnNameIndex:                         NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochValidatorName:        NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameNextEpochGasPrice:             NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochNextGasPrice:         NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochGasPriceDelta:        NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochCommissionRate:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochNextCommissionRate:   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochCommissionRateDelta:  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochStake:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochNextStake:            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochStakeDelta:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochStakeDeltaPercentage: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNextEpochRotations:            NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+	}
+
+	RowsConfigNextEpochChanges = RowsConfig{
+		0: {
+			enums.ColumnNameIndex,
+			enums.ColumnNameNextEpochValidatorName,
+			enums.ColumnNameNextEpochGasPrice,
+			enums.ColumnNameNextEpochNextGasPrice,
+			enums.ColumnNameNextEpochGasPriceDelta,
+			enums.ColumnNameNextEpochCommissionRate,
+			enums.ColumnNameNextEpochNextCommissionRate,
+			enums.ColumnNameNextEpochCommissionRateDelta,
+			enums.ColumnNameNextEpochStake,
+			enums.ColumnNameNextEpochNextStake,
+			enums.ColumnNameNextEpochStakeDelta,
+			enums.ColumnNameNextEpochStakeDeltaPercentage,
+			enums.ColumnNameNextEpochRotations,
+		},
+	}
+)
+
+// GetNextEpochChangesColumnValues returns the column values for the parameters of the validator changing at the end of the epoch.
+// The deltas are signed, and the pending rotations are listed one per line.
+func GetNextEpochChangesColumnValues(idx int, change domainmetrics.ValidatorNextEpochChange) ColumnValues {
+	return ColumnValues{
+		enums.ColumnNameIndex:                         idx + 1,
+		enums.ColumnNameNextEpochValidatorName:        change.Name,
+		enums.ColumnNameNextEpochGasPrice:             change.GasPrice,
+		enums.ColumnNameNextEpochNextGasPrice:         change.NextGasPrice,
+		enums.ColumnNameNextEpochGasPriceDelta:        formatSignedInt(change.GasPriceDelta),
+		enums.ColumnNameNextEpochCommissionRate:       fmt.Sprintf("%.2f", change.CommissionRate),
+		enums.ColumnNameNextEpochNextCommissionRate:   fmt.Sprintf("%.2f", change.NextCommissionRate),
+		enums.ColumnNameNextEpochCommissionRateDelta:  formatSignedFloat(change.CommissionRateDelta),
+		enums.ColumnNameNextEpochStake:                change.Stake,
+		enums.ColumnNameNextEpochNextStake:            change.NextStake,
+		enums.ColumnNameNextEpochStakeDelta:           formatSignedInt(change.StakeDelta),
+		enums.ColumnNameNextEpochStakeDeltaPercentage: formatSignedFloat(change.StakeDeltaPercentage),
+		enums.ColumnNameNextEpochRotations:            strings.Join(change.Rotations, "\n"),
+	}
+}
+
+// formatSignedInt formats the delta with its sign, leaving no change unsigned.
+func formatSignedInt(delta int64) string {
+	if delta == 0 {
+		return "0"
+	}
+
+	return fmt.Sprintf("%+d", delta)
+}
+
+// formatSignedFloat formats the delta with its sign and two decimals, leaving no change unsigned.
+func formatSignedFloat(delta float64) string {
+	formatted := fmt.Sprintf("%+.2f", delta)
+	if formatted == "+0.00" || formatted == "-0.00" {
+		return "0.00"
+	}
+
+	return formatted
+}
@@ -30,7 +30,12 @@ var ColumnsConfigNode = ColumnsConfig{
 	enums.ColumnNameUptime:                       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	enums.ColumnNameVersion:                      NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	enums.ColumnNameCommit:                       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	enums.ColumnNameReleaseStatus:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	enums.ColumnNameReleasesBehind:               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	enums.ColumnNameBuildAge:                     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	enums.ColumnNameCountry:                      NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
+	enums.ColumnNameIPAddresses:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
+	enums.ColumnNameLatency:                      NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
 }
 
 var RowsConfigNode = RowsConfig{
@@ -57,7 +62,12 @@ var RowsConfigNode = RowsConfig{
 		enums.ColumnNameUptime,
 		enums.ColumnNameVersion,
 		enums.ColumnNameCommit,
+		enums.ColumnNameReleaseStatus,
+		enums.ColumnNameReleasesBehind,
+		enums.ColumnNameBuildAge,
+		enums.ColumnNameIPAddresses,
 		enums.ColumnNameCountry,
+		enums.ColumnNameLatency,
 	},
 }
 
@@ -86,22 +96,27 @@ func GetNodeColumnValues(idx int, host *domainhost.Host) ColumnValues {
 		enums.ColumnNameAddress:                      address,
 		enums.ColumnNamePortRPC:                      port,
 		enums.ColumnNameTotalTransactionBlocks:       host.Metrics.TotalTransactionsBlocks,
-		enums.ColumnNameTotalTransactionCertificates: host.Metrics.TotalTransactionCertificates,
-		enums.ColumnNameTotalTransactionEffects:      host.Metrics.TotalTransactionEffects,
+		enums.ColumnNameTotalTransactionCertificates: host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificates, enums.MetricTypeTotalTransactionCertificates),
+		enums.ColumnNameTotalTransactionEffects:      host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionEffects, enums.MetricTypeTotalTransactionEffects),
 		enums.ColumnNameLatestCheckpoint:             host.Metrics.LatestCheckpoint,
-		enums.ColumnNameHighestKnownCheckpoint:       host.Metrics.HighestKnownCheckpoint,
-		enums.ColumnNameHighestSyncedCheckpoint:      host.Metrics.HighestSyncedCheckpoint,
-		enums.ColumnNameLastExecutedCheckpoint:       host.Metrics.LastExecutedCheckpoint,
-		enums.ColumnNameCheckpointExecBacklog:        host.Metrics.CheckpointExecBacklog,
-		enums.ColumnNameCheckpointSyncBacklog:        host.Metrics.CheckpointSyncBacklog,
-		enums.ColumnNameCurrentEpoch:                 host.Metrics.CurrentEpoch,
+		enums.ColumnNameHighestKnownCheckpoint:       host.Metrics.ValueOrNotAvailable(host.Metrics.HighestKnownCheckpoint, enums.MetricTypeHighestKnownCheckpoint),
+		enums.ColumnNameHighestSyncedCheckpoint:      host.Metrics.ValueOrNotAvailable(host.Metrics.HighestSyncedCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameLastExecutedCheckpoint:       host.Metrics.ValueOrNotAvailable(host.Metrics.LastExecutedCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointExecBacklog:        host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointExecBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointSyncBacklog:        host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointSyncBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameCurrentEpoch:                 host.Metrics.ValueOrNotAvailable(host.Metrics.CurrentEpoch, enums.MetricTypeCurrentEpoch),
 		enums.ColumnNameTXSyncPercentage:             fmt.Sprintf("%v%%", host.Metrics.TxSyncPercentage),
 		enums.ColumnNameCheckSyncPercentage:          fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
-		enums.ColumnNameNetworkPeers:                 host.Metrics.NetworkPeers,
-		enums.ColumnNameUptime:                       host.Metrics.Uptime,
-		enums.ColumnNameVersion:                      host.Metrics.Version,
-		enums.ColumnNameCommit:                       host.Metrics.Commit,
+		enums.ColumnNameNetworkPeers:                 host.Metrics.ValueOrNotAvailable(host.Metrics.NetworkPeers, enums.MetricTypeSuiNetworkPeers),
+		enums.ColumnNameUptime:                       host.Metrics.ValueOrNotAvailable(host.Metrics.Uptime, enums.MetricTypeUptime),
+		enums.ColumnNameVersion:                      host.Metrics.ValueOrNotAvailable(host.Metrics.Version, enums.MetricTypeUptime),
+		enums.ColumnNameCommit:                       host.Metrics.ValueOrNotAvailable(host.Metrics.Commit, enums.MetricTypeUptime),
+		enums.ColumnNameReleaseStatus:                host.Metrics.VersionDrift.GetStatus(),
+		enums.ColumnNameReleasesBehind:               host.Metrics.VersionDrift.GetReleasesBehind(),
+		enums.ColumnNameBuildAge:                     host.Metrics.VersionDrift.GetBuildAgeDays(),
 		enums.ColumnNameCountry:                      country,
+		enums.ColumnNameIPAddresses:                  host.GetResolvedIPsDisplay(),
+		enums.ColumnNameLatency:                      host.GetLatencyDisplay(),
 	}
 
 	return columnValues
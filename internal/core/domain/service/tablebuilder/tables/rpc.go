@@ -16,6 +16,7 @@ var (
 		enums.ColumnNameTotalTransactionBlocks: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameLatestCheckpoint:       NewDefaultColumnConfig(text.AlignLeft, text.AlignLeft, false),
 		enums.ColumnNameCurrentEpoch:           NewDefaultColumnConfig(text.AlignLeft, text.AlignLeft, false),
+		enums.ColumnNameLatency:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	}
 	RowsConfigRPC = RowsConfig{
 		0: {
@@ -26,6 +27,7 @@ var (
 			enums.ColumnNameTotalTransactionBlocks,
 			enums.ColumnNameLatestCheckpoint,
 			enums.ColumnNameCurrentEpoch,
+			enums.ColumnNameLatency,
 		},
 	}
 )
@@ -51,5 +53,6 @@ func GetRPCColumnValues(idx int, host *domainhost.Host) ColumnValues {
 		enums.ColumnNameTotalTransactionBlocks: host.Metrics.TotalTransactionsBlocks,
 		enums.ColumnNameLatestCheckpoint:       host.Metrics.LatestCheckpoint,
 		enums.ColumnNameCurrentEpoch:           host.Metrics.SystemState.Epoch,
+		enums.ColumnNameLatency:                host.GetLatency(enums.PortTypeRPC).String(),
 	}
 }
@@ -0,0 +1,114 @@
+package tables
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigStakeDistribution = ColumnsConfig{
+		enums.ColumnNameStakeMetric:       NewDefaultColumnConfig(text.AlignLeft, text.AlignLeft, false),
+		enums.ColumnNameStakeCurrentEpoch: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameStakeNextEpoch:    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameStakeChange:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	}
+	RowsStakeDistribution = RowsConfig{
+		0: {
+			enums.ColumnNameStakeMetric,
+			enums.ColumnNameStakeCurrentEpoch,
+			enums.ColumnNameStakeNextEpoch,
+			enums.ColumnNameStakeChange,
+		},
+	}
+)
+
+// GetStakeDistributionColumnValues returns the column values for each of the figures of the stake distribution,
+// comparing the current epoch with the next one.
+func GetStakeDistributionColumnValues(distributions *domainmetrics.StakeDistributions) []ColumnValues {
+	current, next := distributions.Current, distributions.NextEpoch
+
+	result := []ColumnValues{
+		newStakeIntColumnValues("ACTIVE VALIDATORS", current.Validators, next.Validators),
+		newStakeBigIntColumnValues("TOTAL STAKE, SUI", current.TotalStake, next.TotalStake),
+		newStakeIntColumnValues("NAKAMOTO COEFFICIENT 1/3", current.NakamotoOneThird, next.NakamotoOneThird),
+		newStakeIntColumnValues("NAKAMOTO COEFFICIENT 2/3", current.NakamotoTwoThirds, next.NakamotoTwoThirds),
+		{
+			enums.ColumnNameStakeMetric:       "GINI COEFFICIENT",
+			enums.ColumnNameStakeCurrentEpoch: domainmetrics.FormatGini(current.Gini),
+			enums.ColumnNameStakeNextEpoch:    domainmetrics.FormatGini(next.Gini),
+			enums.ColumnNameStakeChange:       fmt.Sprintf("%+.3f", next.Gini-current.Gini),
+		},
+	}
+
+	for idx, topN := range domainmetrics.StakeTopN {
+		currentShare, nextShare := current.TopNShares[idx], next.TopNShares[idx]
+
+		result = append(result, ColumnValues{
+			enums.ColumnNameStakeMetric:       fmt.Sprintf("TOP %d STAKE SHARE, %%", topN),
+			enums.ColumnNameStakeCurrentEpoch: domainmetrics.FormatShare(currentShare),
+			enums.ColumnNameStakeNextEpoch:    domainmetrics.FormatShare(nextShare),
+			enums.ColumnNameStakeChange:       fmt.Sprintf("%+.2f", (nextShare-currentShare)*100),
+		})
+	}
+
+	for idx := range current.VotingPowerBuckets {
+		result = append(result, newStakeIntColumnValues(
+			getVotingPowerBucketName(idx),
+			current.VotingPowerBuckets[idx],
+			next.VotingPowerBuckets[idx],
+		))
+	}
+
+	return result
+}
+
+// getVotingPowerBucketName returns the name of the voting power histogram bucket at the index.
+func getVotingPowerBucketName(idx int) string {
+	bounds := domainmetrics.VotingPowerBuckets
+
+	formatBound := func(bound float64) string {
+		return strconv.FormatFloat(bound, 'f', -1, 64)
+	}
+
+	switch idx {
+	case 0:
+		return fmt.Sprintf("VALIDATORS WITH VOTING POWER < %s%%", formatBound(bounds[0]))
+	case len(bounds):
+		return fmt.Sprintf("VALIDATORS WITH VOTING POWER >= %s%%", formatBound(bounds[len(bounds)-1]))
+	default:
+		return fmt.Sprintf("VALIDATORS WITH VOTING POWER %s%% - %s%%", formatBound(bounds[idx-1]), formatBound(bounds[idx]))
+	}
+}
+
+// newStakeIntColumnValues returns the column values of an integer figure of the stake distribution.
+func newStakeIntColumnValues(metric string, current, next int) ColumnValues {
+	return ColumnValues{
+		enums.ColumnNameStakeMetric:       metric,
+		enums.ColumnNameStakeCurrentEpoch: current,
+		enums.ColumnNameStakeNextEpoch:    next,
+		enums.ColumnNameStakeChange:       fmt.Sprintf("%+d", next-current),
+	}
+}
+
+// newStakeBigIntColumnValues returns the column values of a big integer figure of the stake distribution.
+func newStakeBigIntColumnValues(metric string, current, next *big.Int) ColumnValues {
+	change := new(big.Int).Sub(next, current)
+
+	changeValue := change.String()
+	if change.Sign() >= 0 {
+		changeValue = "+" + changeValue
+	}
+
+	return ColumnValues{
+		enums.ColumnNameStakeMetric:       metric,
+		enums.ColumnNameStakeCurrentEpoch: current.String(),
+		enums.ColumnNameStakeNextEpoch:    next.String(),
+		enums.ColumnNameStakeChange:       changeValue,
+	}
+}
@@ -0,0 +1,54 @@
+package tables
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const forecastTimeLayout = "2006-01-02 15:04"
+
+var (
+	ColumnsConfigSubsidyForecast = ColumnsConfig{
+		enums.ColumnNameIndex:                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastEpochs:          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastStart:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastDistribution:    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastDistributed:     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastBalance:         NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastRewardsPerEpoch: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameForecastRewardsApy:      NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	}
+
+	RowsConfigSubsidyForecast = RowsConfig{
+		0: {
+			enums.ColumnNameIndex,
+			enums.ColumnNameForecastEpochs,
+			enums.ColumnNameForecastStart,
+			enums.ColumnNameForecastDistribution,
+			enums.ColumnNameForecastDistributed,
+			enums.ColumnNameForecastBalance,
+			enums.ColumnNameForecastRewardsPerEpoch,
+			enums.ColumnNameForecastRewardsApy,
+		},
+	}
+)
+
+// GetSubsidyForecastColumnValues returns the column values for the subsidy period of the forecast.
+// The amounts are rounded to whole SUI, except for the staking rewards.
+func GetSubsidyForecastColumnValues(idx int, forecast *domainmetrics.SubsidyForecast, period domainmetrics.SubsidyForecastPeriod) ColumnValues {
+	return ColumnValues{
+		enums.ColumnNameIndex:                   idx + 1,
+		enums.ColumnNameForecastEpochs:          fmt.Sprintf("%d - %d", period.StartEpoch, period.EndEpoch),
+		enums.ColumnNameForecastStart:           forecast.GetEpochStart(period.StartEpoch).UTC().Format(forecastTimeLayout),
+		enums.ColumnNameForecastDistribution:    int64(math.Round(period.DistributionSui)),
+		enums.ColumnNameForecastDistributed:     int64(math.Round(period.DistributedSui)),
+		enums.ColumnNameForecastBalance:         int64(math.Round(period.BalanceSui)),
+		enums.ColumnNameForecastRewardsPerEpoch: fmt.Sprintf("%.4f", period.RewardsPerEpochSui),
+		enums.ColumnNameForecastRewardsApy:      fmt.Sprintf("%.2f", period.RewardsApyPercentage),
+	}
+}
@@ -11,33 +11,40 @@ import (
 
 var (
 	ColumnsConfigValidator = ColumnsConfig{
-		enums.ColumnNameIndex:                                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameHealth:                                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameAddress:                                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameTotalTransactionCertificates:            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameTotalTransactionCertificatesCreated:     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameTotalTransactionEffects:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameHighestKnownCheckpoint:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameHighestSyncedCheckpoint:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameLastExecutedCheckpoint:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCheckpointExecBacklog:                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCheckpointSyncBacklog:                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCurrentEpoch:                            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCheckSyncPercentage:                     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameNetworkPeers:                            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameUptime:                                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameVersion:                                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCommit:                                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameCountry:                                 NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
-		enums.ColumnNameLastCommittedLeaderRound:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameHighestAcceptedRound:                    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameSkippedConsensusTransactions:            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameTotalSignatureErrors:                    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameHandleCertificateNonConsensusLatencySum: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameValidatorCurrentVotingRight:             NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameValidatorTotalTransactionCertificates:   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
-		enums.ColumnNameNumberSharedObjectTransactions:          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameIndex:                                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameHealth:                                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameAddress:                               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameTotalTransactionCertificates:          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameTotalTransactionCertificatesCreated:   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameTotalTransactionEffects:               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameHighestKnownCheckpoint:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameHighestSyncedCheckpoint:               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameLastExecutedCheckpoint:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCheckpointExecBacklog:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCheckpointSyncBacklog:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCurrentEpoch:                          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCheckSyncPercentage:                   NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNetworkPeers:                          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameUptime:                                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameVersion:                               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCommit:                                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReleaseStatus:                         NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReleasesBehind:                        NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameBuildAge:                              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCountry:                               NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameIPAddresses:                           NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
+		enums.ColumnNameLastCommittedLeaderRound:              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameHighestAcceptedRound:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameSkippedConsensusTransactions:          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameTotalSignatureErrors:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameSkippedConsensusTxPerMinute:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameSignatureErrorsPerMinute:              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCertificateNonConsensusLatency:        NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameCertificateConsensusLatency:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorCurrentVotingRight:           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorTotalTransactionCertificates: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameNumberSharedObjectTransactions:        NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	}
 
 	RowsConfigValidator = RowsConfig{
@@ -45,11 +52,13 @@ var (
 			enums.ColumnNameIndex,
 			enums.ColumnNameHealth,
 			enums.ColumnNameAddress,
+			enums.ColumnNameIPAddresses,
 			enums.ColumnNameCurrentEpoch,
 			enums.ColumnNameValidatorCurrentVotingRight,
 			enums.ColumnNameTotalTransactionCertificates,
 			enums.ColumnNameTotalTransactionCertificatesCreated,
 			enums.ColumnNameSkippedConsensusTransactions,
+			enums.ColumnNameSkippedConsensusTxPerMinute,
 			enums.ColumnNameTotalTransactionEffects,
 		},
 		1: {
@@ -61,6 +70,8 @@ var (
 			enums.ColumnNameUptime,
 			enums.ColumnNameVersion,
 			enums.ColumnNameCommit,
+			enums.ColumnNameReleaseStatus,
+			enums.ColumnNameReleasesBehind,
 			enums.ColumnNameLastCommittedLeaderRound,
 		},
 		2: {
@@ -68,7 +79,11 @@ var (
 			enums.ColumnNameConsensusRoundProberCurrentRoundGaps,
 			enums.ColumnNameNetworkPeers,
 			enums.ColumnNameTotalSignatureErrors,
+			enums.ColumnNameSignatureErrorsPerMinute,
 			enums.ColumnNameNumberSharedObjectTransactions,
+			enums.ColumnNameCertificateNonConsensusLatency,
+			enums.ColumnNameCertificateConsensusLatency,
+			enums.ColumnNameBuildAge,
 		},
 	}
 )
@@ -88,33 +103,40 @@ func GetValidatorColumnValues(idx int, host *domainhost.Host) ColumnValues {
 	address := host.Endpoint.Address
 
 	columnValues := ColumnValues{
-		enums.ColumnNameIndex:                                   idx + 1,
-		enums.ColumnNameHealth:                                  status,
-		enums.ColumnNameAddress:                                 address,
-		enums.ColumnNameTotalTransactionCertificates:            host.Metrics.TotalTransactionCertificates,
-		enums.ColumnNameTotalTransactionEffects:                 host.Metrics.TotalTransactionEffects,
-		enums.ColumnNameHighestKnownCheckpoint:                  host.Metrics.HighestKnownCheckpoint,
-		enums.ColumnNameHighestSyncedCheckpoint:                 host.Metrics.HighestSyncedCheckpoint,
-		enums.ColumnNameLastExecutedCheckpoint:                  host.Metrics.LastExecutedCheckpoint,
-		enums.ColumnNameCheckpointExecBacklog:                   host.Metrics.CheckpointExecBacklog,
-		enums.ColumnNameCheckpointSyncBacklog:                   host.Metrics.CheckpointSyncBacklog,
-		enums.ColumnNameCurrentEpoch:                            host.Metrics.CurrentEpoch,
-		enums.ColumnNameCheckSyncPercentage:                     fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
-		enums.ColumnNameNetworkPeers:                            host.Metrics.NetworkPeers,
-		enums.ColumnNameUptime:                                  host.Metrics.Uptime,
-		enums.ColumnNameVersion:                                 host.Metrics.Version,
-		enums.ColumnNameCommit:                                  host.Metrics.Commit,
-		enums.ColumnNameLastCommittedLeaderRound:                host.Metrics.LastCommittedLeaderRound,
-		enums.ColumnNameHighestAcceptedRound:                    host.Metrics.HighestAcceptedRound,
-		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:    host.Metrics.ConsensusRoundProberCurrentRoundGaps,
-		enums.ColumnNameSkippedConsensusTransactions:            host.Metrics.SkippedConsensusTransactions,
-		enums.ColumnNameTotalSignatureErrors:                    host.Metrics.TotalSignatureErrors,
-		enums.ColumnNameTotalTransactionCertificatesCreated:     host.Metrics.TotalTransactionCertificatesCreated,
-		enums.ColumnNameHandleCertificateNonConsensusLatencySum: host.Metrics.NonConsensusLatency,
-		enums.ColumnNameCountry:                                 country,
-		enums.ColumnNameValidatorCurrentVotingRight:             fmt.Sprintf("%v%%", host.Metrics.CurrentVotingRight),
-		enums.ColumnNameValidatorTotalTransactionCertificates:   host.Metrics.TotalTransactionCertificates,
-		enums.ColumnNameNumberSharedObjectTransactions:          host.Metrics.NumberSharedObjectTransactions,
+		enums.ColumnNameIndex:                                 idx + 1,
+		enums.ColumnNameHealth:                                status,
+		enums.ColumnNameAddress:                               address,
+		enums.ColumnNameTotalTransactionCertificates:          host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificates, enums.MetricTypeTotalTransactionCertificates),
+		enums.ColumnNameTotalTransactionEffects:               host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionEffects, enums.MetricTypeTotalTransactionEffects),
+		enums.ColumnNameHighestKnownCheckpoint:                host.Metrics.ValueOrNotAvailable(host.Metrics.HighestKnownCheckpoint, enums.MetricTypeHighestKnownCheckpoint),
+		enums.ColumnNameHighestSyncedCheckpoint:               host.Metrics.ValueOrNotAvailable(host.Metrics.HighestSyncedCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameLastExecutedCheckpoint:                host.Metrics.ValueOrNotAvailable(host.Metrics.LastExecutedCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointExecBacklog:                 host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointExecBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeLastExecutedCheckpoint),
+		enums.ColumnNameCheckpointSyncBacklog:                 host.Metrics.ValueOrNotAvailable(host.Metrics.CheckpointSyncBacklog, enums.MetricTypeHighestKnownCheckpoint, enums.MetricTypeHighestSyncedCheckpoint),
+		enums.ColumnNameCurrentEpoch:                          host.Metrics.ValueOrNotAvailable(host.Metrics.CurrentEpoch, enums.MetricTypeCurrentEpoch),
+		enums.ColumnNameCheckSyncPercentage:                   fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
+		enums.ColumnNameNetworkPeers:                          host.Metrics.ValueOrNotAvailable(host.Metrics.NetworkPeers, enums.MetricTypeSuiNetworkPeers),
+		enums.ColumnNameUptime:                                host.Metrics.ValueOrNotAvailable(host.Metrics.Uptime, enums.MetricTypeUptime),
+		enums.ColumnNameVersion:                               host.Metrics.ValueOrNotAvailable(host.Metrics.Version, enums.MetricTypeUptime),
+		enums.ColumnNameCommit:                                host.Metrics.ValueOrNotAvailable(host.Metrics.Commit, enums.MetricTypeUptime),
+		enums.ColumnNameReleaseStatus:                         host.Metrics.VersionDrift.GetStatus(),
+		enums.ColumnNameReleasesBehind:                        host.Metrics.VersionDrift.GetReleasesBehind(),
+		enums.ColumnNameBuildAge:                              host.Metrics.VersionDrift.GetBuildAgeDays(),
+		enums.ColumnNameLastCommittedLeaderRound:              host.Metrics.ValueOrNotAvailable(host.Metrics.LastCommittedLeaderRound, enums.MetricTypeConsensusLastCommittedLeaderRound),
+		enums.ColumnNameHighestAcceptedRound:                  host.Metrics.ValueOrNotAvailable(host.Metrics.HighestAcceptedRound, enums.MetricTypeConsensusHighestAcceptedRound),
+		enums.ColumnNameConsensusRoundProberCurrentRoundGaps:  host.Metrics.ValueOrNotAvailable(host.Metrics.ConsensusRoundProberCurrentRoundGaps, enums.MetricTypeConsensusRoundProberCurrentRoundGaps),
+		enums.ColumnNameSkippedConsensusTransactions:          host.Metrics.ValueOrNotAvailable(host.Metrics.SkippedConsensusTransactions, enums.MetricTypeSkippedConsensusTransactions),
+		enums.ColumnNameTotalSignatureErrors:                  host.Metrics.ValueOrNotAvailable(host.Metrics.TotalSignatureErrors, enums.MetricTypeTotalSignatureErrors),
+		enums.ColumnNameSkippedConsensusTxPerMinute:           host.Metrics.ValueOrNotAvailable(host.Metrics.Rate(enums.MetricTypeSkippedConsensusTransactions).FormatPerMinute(), enums.MetricTypeSkippedConsensusTransactions),
+		enums.ColumnNameSignatureErrorsPerMinute:              host.Metrics.ValueOrNotAvailable(host.Metrics.Rate(enums.MetricTypeTotalSignatureErrors).FormatPerMinute(), enums.MetricTypeTotalSignatureErrors),
+		enums.ColumnNameTotalTransactionCertificatesCreated:   host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificatesCreated, enums.MetricTypeTotalTransactionCertificatesCreated),
+		enums.ColumnNameCertificateNonConsensusLatency:        host.Metrics.ValueOrNotAvailable(host.Metrics.CertificateNonConsensusLatency.Stats().Milliseconds(), enums.MetricTypeCertificateNonConsensusLatency),
+		enums.ColumnNameCertificateConsensusLatency:           host.Metrics.ValueOrNotAvailable(host.Metrics.CertificateConsensusLatency.Stats().Milliseconds(), enums.MetricTypeCertificateConsensusLatency),
+		enums.ColumnNameCountry:                               country,
+		enums.ColumnNameIPAddresses:                           host.GetResolvedIPsDisplay(),
+		enums.ColumnNameValidatorCurrentVotingRight:           host.Metrics.ValueOrNotAvailable(fmt.Sprintf("%v%%", host.Metrics.CurrentVotingRight), enums.MetricTypeCurrentVotingRight),
+		enums.ColumnNameValidatorTotalTransactionCertificates: host.Metrics.ValueOrNotAvailable(host.Metrics.TotalTransactionCertificates, enums.MetricTypeTotalTransactionCertificates),
+		enums.ColumnNameNumberSharedObjectTransactions:        host.Metrics.ValueOrNotAvailable(host.Metrics.NumberSharedObjectTransactions, enums.MetricTypeNumberSharedObjectTransactions),
 	}
 
 	return columnValues
@@ -0,0 +1,53 @@
+package tables
+
+import (
+	"github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigValidatorSet = ColumnsConfig{
+		enums.ColumnNameIndex:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorSetChange:    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorSetName:      NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameValidatorSetAddress:   NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
+		enums.ColumnNameValidatorSetStake:     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorSetNextStake: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+	}
+
+	RowsConfigValidatorSet = RowsConfig{
+		0: {
+			enums.ColumnNameIndex,
+			enums.ColumnNameValidatorSetChange,
+			enums.ColumnNameValidatorSetName,
+			enums.ColumnNameValidatorSetAddress,
+			enums.ColumnNameValidatorSetStake,
+			enums.ColumnNameValidatorSetNextStake,
+		},
+	}
+)
+
+// GetValidatorSetColumnValues returns the column values for the validator joined, left, pending or candidate.
+// The stakes are converted to SUI, and shown as not available if unknown.
+func GetValidatorSetColumnValues(idx int, entry domainmetrics.ValidatorSetEntry) ColumnValues {
+	return ColumnValues{
+		enums.ColumnNameIndex:                 idx + 1,
+		enums.ColumnNameValidatorSetChange:    string(entry.Change),
+		enums.ColumnNameValidatorSetName:      entry.Name,
+		enums.ColumnNameValidatorSetAddress:   entry.Address,
+		enums.ColumnNameValidatorSetStake:     formatMistAsSui(entry.Stake),
+		enums.ColumnNameValidatorSetNextStake: formatMistAsSui(entry.NextEpochStake),
+	}
+}
+
+// formatMistAsSui converts the MIST amount to SUI, or returns the not available value if it cannot be parsed.
+func formatMistAsSui(mist string) any {
+	sui, err := domainmetrics.MistToSui(mist)
+	if err != nil {
+		return domainmetrics.NotAvailable
+	}
+
+	return sui
+}
@@ -6,33 +6,47 @@ import (
 	"time"
 
 	"github.com/ipinfo/go/v2/ipinfo"
-	"github.com/ipinfo/go/v2/ipinfo/cache"
 
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
 )
 
-const (
-	ipInfoCacheExp    = 5 * time.Minute
-	httpClientTimeout = 4 * time.Second
-)
+const httpClientTimeout = 4 * time.Second
 
 type Gateway struct {
-	ctx         context.Context
-	client      *ipinfo.Client
+	transport   http.RoundTripper
 	cliGateway  *cligw.Gateway
 	accessToken string
 }
 
-func NewGateway(cliGW *cligw.Gateway, accessToken string) ports.GeoGateway {
-	httpClient := &http.Client{Timeout: httpClientTimeout}
-	infoCache := ipinfo.NewCache(cache.NewInMemory().WithExpiration(ipInfoCacheExp))
-	geoClient := ipinfo.NewClient(httpClient, infoCache, accessToken)
+// contextTransport binds the requests sent through it to the context, as the ipinfo client does not accept one,
+// so the requests in flight are aborted as soon as the context is done.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
 
+// NewGateway creates the ipinfo.io gateway. The results are not cached in memory, see NewCachedGateway for the on-disk cache.
+func NewGateway(cliGW *cligw.Gateway, accessToken string) ports.GeoGateway {
 	return &Gateway{
-		ctx:         context.Background(),
 		accessToken: accessToken,
-		client:      geoClient,
+		transport:   http.DefaultTransport,
 		cliGateway:  cliGW,
 	}
 }
+
+// newClient creates the ipinfo client sending its requests with the context.
+// The clients share the transport, so the connections are reused across the lookups.
+func (gateway *Gateway) newClient(ctx context.Context) *ipinfo.Client {
+	httpClient := &http.Client{
+		Timeout:   httpClientTimeout,
+		Transport: &contextTransport{ctx: ctx, base: gateway.transport},
+	}
+
+	return ipinfo.NewClient(httpClient, nil, gateway.accessToken)
+}
+
+// RoundTrip sends the request with the context of the transport.
+func (transport *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return transport.base.RoundTrip(req.WithContext(transport.ctx))
+}
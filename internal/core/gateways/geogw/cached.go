@@ -0,0 +1,56 @@
+package geogw
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/diskcache"
+)
+
+// CachedGateway serves the geolocation data from the on-disk cache and looks up only the addresses
+// not cached yet or expired with the wrapped gateway. The cache is shared by all the runs and hosts.
+type CachedGateway struct {
+	gateway    ports.GeoGateway
+	cache      *diskcache.Cache
+	cliGateway *cligw.Gateway
+}
+
+// NewCachedGateway wraps the gateway with the on-disk cache.
+func NewCachedGateway(cliGW *cligw.Gateway, gateway ports.GeoGateway, cache *diskcache.Cache) ports.GeoGateway {
+	return &CachedGateway{
+		gateway:    gateway,
+		cache:      cache,
+		cliGateway: cliGW,
+	}
+}
+
+// CallFor returns the cached geolocation data for the provided IP address or looks it up with the wrapped gateway and caches it.
+// The cache errors are reported as warnings and do not fail the lookup.
+func (gateway *CachedGateway) CallFor(ctx context.Context, ip net.IP) (*ports.IPResult, error) {
+	key := ip.String()
+
+	var cached ports.IPResult
+
+	found, err := gateway.cache.Get(key, &cached)
+	if err != nil {
+		gateway.cliGateway.Warn(fmt.Sprintf("failed to read geolocation cache: %v", err))
+	}
+
+	if found {
+		return &cached, nil
+	}
+
+	result, err := gateway.gateway.CallFor(ctx, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gateway.cache.Set(key, result); err != nil {
+		gateway.cliGateway.Warn(fmt.Sprintf("failed to write geolocation cache: %v", err))
+	}
+
+	return result, nil
+}
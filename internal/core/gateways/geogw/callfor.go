@@ -1,19 +1,30 @@
 package geogw
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/bartosian/suimon/internal/core/ports"
 )
 
-func (gateway *Gateway) CallFor(ip net.IP) (result *ports.IPResult, err error) {
+// CallFor requests the geolocation data for the provided IP address.
+// The request is aborted when the provided context is done or the client timeout expires.
+func (gateway *Gateway) CallFor(ctx context.Context, ip net.IP) (result *ports.IPResult, err error) {
 	if ip == nil {
 		return nil, fmt.Errorf("no IP provided")
 	}
 
-	data, err := gateway.client.GetIPInfo(ip)
+	ctx, cancel := context.WithTimeout(ctx, httpClientTimeout)
+	defer cancel()
+
+	data, err := gateway.newClient(ctx).GetIPInfo(ip)
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("ip lookup timed out for %s: %w", ip, ctx.Err())
+		}
+
 		return nil, fmt.Errorf("failed to get IP data for %s: %w", ip, err)
 	}
 
@@ -27,6 +38,22 @@ func (gateway *Gateway) CallFor(ip net.IP) (result *ports.IPResult, err error) {
 		}
 	}
 
+	var asn string
+	if data.ASN != nil {
+		asn = data.ASN.ASN
+	}
+
+	// The free plan returns the ASN and the provider only in the organization, e.g. "AS16509 Amazon.com, Inc.".
+	if orgASN, orgName, found := strings.Cut(data.Org, " "); found && strings.HasPrefix(orgASN, "AS") {
+		if asn == "" {
+			asn = orgASN
+		}
+
+		if company.Name == "" {
+			company.Name = orgName
+		}
+	}
+
 	return &ports.IPResult{
 		IP:           data.IP,
 		Hostname:     data.Hostname,
@@ -36,6 +63,7 @@ func (gateway *Gateway) CallFor(ip net.IP) (result *ports.IPResult, err error) {
 		CountryName:  data.CountryName,
 		CountryEmoji: data.CountryFlag.Emoji,
 		Location:     data.Location,
+		ASN:          asn,
 		Company:      company,
 	}, nil
 }
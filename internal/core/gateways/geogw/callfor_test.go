@@ -0,0 +1,49 @@
+package geogw
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// blockingTransport holds the requests until their context is done, reporting the context error.
+type blockingTransport struct {
+	aborted chan error
+}
+
+func (transport *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+
+	transport.aborted <- req.Context().Err()
+
+	return nil, req.Context().Err()
+}
+
+// TestCallForCanceled checks that canceling the context aborts the request in flight.
+func TestCallForCanceled(t *testing.T) {
+	transport := &blockingTransport{aborted: make(chan error, 1)}
+	gateway := &Gateway{transport: transport, accessToken: "token"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	if _, err := gateway.CallFor(ctx, net.ParseIP("1.1.1.1")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("CallFor error = %v, want context.Canceled", err)
+	}
+
+	select {
+	case err := <-transport.aborted:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("request aborted with %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("request was not aborted")
+	}
+}
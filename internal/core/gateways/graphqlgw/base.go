@@ -0,0 +1,42 @@
+package graphqlgw
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/latency"
+)
+
+const (
+	graphqlClientTimeout = 3 * time.Second
+	graphqlCallTimeout   = 10 * time.Second
+)
+
+// Gateway implements ports.RPCGateway on top of the Sui GraphQL RPC service.
+// The results are converted into the same shapes returned by the JSON-RPC API.
+type Gateway struct {
+	client     *http.Client
+	cliGateway *cligw.Gateway
+	latency    *latency.Tracker
+	url        string
+}
+
+func NewGateway(cliGW *cligw.Gateway, url string) ports.RPCGateway {
+	httpClient := &http.Client{
+		Timeout: graphqlClientTimeout,
+	}
+
+	return &Gateway{
+		url:        url,
+		client:     httpClient,
+		cliGateway: cliGW,
+		latency:    latency.NewTracker(),
+	}
+}
+
+// Latency returns the round-trip time statistics for the requests made through the gateway.
+func (gateway *Gateway) Latency() latency.Stats {
+	return gateway.latency.Stats()
+}
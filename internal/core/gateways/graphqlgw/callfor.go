@@ -0,0 +1,244 @@
+package graphqlgw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// CallFor fetches the data for the specified RPC method from the GraphQL service.
+// The result is returned in the same shape as the JSON-RPC API returns it for the method,
+// so it can be consumed by the existing metrics parsers.
+// The call is canceled when the provided context is done or the call timeout expires.
+func (gateway *Gateway) CallFor(ctx context.Context, method enums.RPCMethod, params ...interface{}) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, graphqlCallTimeout)
+	defer cancel()
+
+	//nolint:exhaustive // only the methods used by suimon are supported
+	switch method {
+	case enums.RPCMethodGetTotalTransactionBlocks:
+		checkpoint, checkpointErr := gateway.getCheckpoint(ctx)
+		if checkpointErr != nil {
+			return nil, checkpointErr
+		}
+
+		return checkpoint.Checkpoint.NetworkTotalTransactions.String(), nil
+	case enums.RPCMethodGetLatestCheckpointSequenceNumber:
+		checkpoint, checkpointErr := gateway.getCheckpoint(ctx)
+		if checkpointErr != nil {
+			return nil, checkpointErr
+		}
+
+		return checkpoint.Checkpoint.SequenceNumber.String(), nil
+	case enums.RPCMethodGetSuiSystemState:
+		return gateway.getSystemState(ctx)
+	case enums.RPCMethodGetValidatorsApy:
+		return gateway.getValidatorsApy(ctx)
+	case enums.RPCMethodGetProtocol:
+		var version any
+		if len(params) > 0 {
+			version = params[0]
+		}
+
+		return gateway.getProtocolConfig(ctx, version)
+	default:
+		return nil, fmt.Errorf("unsupported graphql method: %s", method)
+	}
+}
+
+// getCheckpoint fetches the latest checkpoint sequence number and the network total transactions.
+func (gateway *Gateway) getCheckpoint(ctx context.Context) (*checkpointData, error) {
+	var data checkpointData
+	if err := gateway.query(ctx, queryCheckpoint, nil, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// getProtocolConfig fetches the protocol config for the given version, or the latest one if the version is nil.
+// The version is passed as a string, as done for JSON-RPC, and is converted into the number GraphQL expects.
+func (gateway *Gateway) getProtocolConfig(ctx context.Context, version any) (map[string]interface{}, error) {
+	if versionString, ok := version.(string); ok {
+		versionNumber, err := strconv.ParseUint(versionString, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid protocol version: %s", versionString)
+		}
+
+		version = versionNumber
+	}
+
+	var data protocolConfigData
+	if err := gateway.query(ctx, queryProtocolConfig, map[string]any{"version": version}, &data); err != nil {
+		return nil, err
+	}
+
+	return convertProtocolConfig(&data), nil
+}
+
+// getValidatorsApy fetches the APYs of all the active validators page by page.
+func (gateway *Gateway) getValidatorsApy(ctx context.Context) (map[string]interface{}, error) {
+	var (
+		apys  []interface{}
+		after any
+	)
+
+	for {
+		var data validatorsApyData
+		if err := gateway.query(ctx, queryValidatorsApy, map[string]any{"first": validatorsPageSize, "after": after}, &data); err != nil {
+			return nil, err
+		}
+
+		validators := data.Epoch.ValidatorSet.ActiveValidators
+
+		for _, node := range validators.Nodes {
+			apy, err := convertApy(node.Apy)
+			if err != nil {
+				return nil, err
+			}
+
+			apys = append(apys, map[string]interface{}{
+				"address": node.Address.Address,
+				"apy":     apy,
+			})
+		}
+
+		if !validators.PageInfo.HasNextPage {
+			break
+		}
+
+		after = validators.PageInfo.EndCursor
+	}
+
+	return map[string]interface{}{"apys": apys}, nil
+}
+
+// getSystemState fetches the latest system state together with all the active validators page by page.
+func (gateway *Gateway) getSystemState(ctx context.Context) (map[string]interface{}, error) {
+	var (
+		state      *systemStateData
+		validators []validator
+		after      any
+	)
+
+	for {
+		var data systemStateData
+		if err := gateway.query(ctx, querySystemState, map[string]any{"first": validatorsPageSize, "after": after}, &data); err != nil {
+			return nil, err
+		}
+
+		if state == nil {
+			state = &data
+		}
+
+		activeValidators := data.Epoch.ValidatorSet.ActiveValidators
+
+		for idx := range activeValidators.Nodes {
+			if err := gateway.getReportRecords(ctx, after, idx, &activeValidators.Nodes[idx]); err != nil {
+				return nil, err
+			}
+		}
+
+		validators = append(validators, activeValidators.Nodes...)
+
+		if !activeValidators.PageInfo.HasNextPage {
+			break
+		}
+
+		after = activeValidators.PageInfo.EndCursor
+	}
+
+	return convertSystemState(state, validators)
+}
+
+// getReportRecords fetches the remaining pages of the report records of the validator at the index of the page
+// of the active validators starting after the cursor, appending them to the records of the validator.
+func (gateway *Gateway) getReportRecords(ctx context.Context, validatorsAfter any, idx int, node *validator) error {
+	for node.ReportRecords.PageInfo.HasNextPage {
+		variables := map[string]any{
+			"validators":      idx + 1,
+			"validatorsAfter": validatorsAfter,
+			"first":           validatorsPageSize,
+			"after":           node.ReportRecords.PageInfo.EndCursor,
+		}
+
+		var data reportRecordsData
+		if err := gateway.query(ctx, queryReportRecords, variables, &data); err != nil {
+			return err
+		}
+
+		nodes := data.Epoch.ValidatorSet.ActiveValidators.Nodes
+		if len(nodes) <= idx || nodes[idx].Address.Address != node.Address.Address {
+			return fmt.Errorf("active validators changed while fetching the report records of %s", node.Address.Address)
+		}
+
+		records := nodes[idx].ReportRecords
+		node.ReportRecords.Nodes = append(node.ReportRecords.Nodes, records.Nodes...)
+		node.ReportRecords.PageInfo = records.PageInfo
+	}
+
+	return nil
+}
+
+// query sends the GraphQL query with the given variables and decodes the returned data into the result.
+// It returns an error if the request fails or the response contains GraphQL errors.
+func (gateway *Gateway) query(ctx context.Context, query string, variables map[string]any, result any) error {
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to encode graphql request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gateway.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create graphql request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+
+	resp, err := gateway.client.Do(req)
+
+	gateway.latency.Since(start)
+
+	if err != nil {
+		return fmt.Errorf("failed to get response from graphql client: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected graphql response status: %s", resp.Status)
+	}
+
+	var payload response
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return fmt.Errorf("failed to decode graphql response: %w", err)
+	}
+
+	if len(payload.Errors) > 0 {
+		messages := make([]string, 0, len(payload.Errors))
+		for _, queryErr := range payload.Errors {
+			messages = append(messages, queryErr.Message)
+		}
+
+		return fmt.Errorf("graphql query failed: %s", strings.Join(messages, "; "))
+	}
+
+	if len(payload.Data) == 0 || bytes.Equal(payload.Data, []byte("null")) {
+		return errors.New("graphql response contains no data")
+	}
+
+	if err := json.Unmarshal(payload.Data, result); err != nil {
+		return fmt.Errorf("failed to decode graphql response data: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package graphqlgw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// TestGetSystemStateReportRecords checks that the report records listing the validators reported by each validator
+// are inverted into the JSON-RPC records listing the reporters of each reported validator, including the records
+// fetched from the next pages.
+func TestGetSystemStateReportRecords(t *testing.T) {
+	systemState := `{"data": {"epoch": {
+		"epochId": 100,
+		"startTimestamp": "2024-01-01T00:00:00Z",
+		"validatorSet": {"activeValidators": {
+			"pageInfo": {"hasNextPage": false, "endCursor": "v3"},
+			"nodes": [
+				{"address": {"address": "0xa"}, "reportRecords": {
+					"pageInfo": {"hasNextPage": true, "endCursor": "r1"},
+					"nodes": [{"address": {"address": "0xb"}}]
+				}},
+				{"address": {"address": "0xb"}, "reportRecords": {
+					"pageInfo": {"hasNextPage": false},
+					"nodes": [{"address": {"address": "0xc"}}]
+				}},
+				{"address": {"address": "0xc"}, "reportRecords": {"pageInfo": {"hasNextPage": false}, "nodes": []}}
+			]
+		}}
+	}}}`
+
+	reportRecords := `{"data": {"epoch": {"validatorSet": {"activeValidators": {"nodes": [
+		{"address": {"address": "0xa"}, "reportRecords": {
+			"pageInfo": {"hasNextPage": false},
+			"nodes": [{"address": {"address": "0xc"}}]
+		}}
+	]}}}}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+
+		if strings.Contains(req.Query, "$validatorsAfter") {
+			if req.Variables["after"] != "r1" || req.Variables["validators"] != float64(1) {
+				t.Errorf("unexpected report records variables: %v", req.Variables)
+			}
+
+			_, _ = w.Write([]byte(reportRecords))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(systemState))
+	}))
+	defer server.Close()
+
+	gateway := NewGateway(nil, server.URL)
+
+	result, err := gateway.CallFor(context.Background(), enums.RPCMethodGetSuiSystemState)
+	if err != nil {
+		t.Fatalf("CallFor failed: %v", err)
+	}
+
+	state, ok := result.(map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected result type: %T", result)
+	}
+
+	expected := []interface{}{
+		[]interface{}{"0xb", []interface{}{"0xa"}},
+		[]interface{}{"0xc", []interface{}{"0xa", "0xb"}},
+	}
+
+	if records := state["validatorReportRecords"]; !reflect.DeepEqual(records, expected) {
+		t.Errorf("validatorReportRecords = %v, want %v", records, expected)
+	}
+}
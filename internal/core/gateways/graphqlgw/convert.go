@@ -0,0 +1,267 @@
+package graphqlgw
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const apyBasisPoints = 10_000
+
+// convertSystemState maps the GraphQL epoch data and active validators into the JSON-RPC system state shape.
+func convertSystemState(data *systemStateData, validators []validator) (map[string]interface{}, error) {
+	epoch := data.Epoch
+
+	startTimestamp, err := time.Parse(time.RFC3339Nano, epoch.StartTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch start timestamp: %s", epoch.StartTimestamp)
+	}
+
+	systemState := metrics.SuiSystemState{
+		Epoch:                                 epoch.EpochID.String(),
+		ProtocolVersion:                       epoch.ProtocolConfigs.ProtocolVersion.String(),
+		SystemStateVersion:                    epoch.SystemStateVersion.String(),
+		StorageFundTotalObjectStorageRebates:  epoch.StorageFund.TotalObjectStorageRebates.String(),
+		StorageFundNonRefundableBalance:       epoch.StorageFund.NonRefundableBalance.String(),
+		ReferenceGasPrice:                     epoch.ReferenceGasPrice.String(),
+		SafeModeStorageRewards:                epoch.SafeMode.GasSummary.StorageCost.String(),
+		SafeModeComputationRewards:            epoch.SafeMode.GasSummary.ComputationCost.String(),
+		SafeModeStorageRebates:                epoch.SafeMode.GasSummary.StorageRebate.String(),
+		SafeModeNonRefundableStorageFee:       epoch.SafeMode.GasSummary.NonRefundableStorageFee.String(),
+		EpochStartTimestampMs:                 strconv.FormatInt(startTimestamp.UnixMilli(), 10),
+		EpochDurationMs:                       epoch.SystemParameters.DurationMs.String(),
+		StakeSubsidyStartEpoch:                epoch.SystemParameters.StakeSubsidyStartEpoch.String(),
+		MaxValidatorCount:                     epoch.SystemParameters.MaxValidatorCount.String(),
+		MinValidatorJoiningStake:              epoch.SystemParameters.MinValidatorJoiningStake.String(),
+		ValidatorLowStakeThreshold:            epoch.SystemParameters.ValidatorLowStakeThreshold.String(),
+		ValidatorVeryLowStakeThreshold:        epoch.SystemParameters.ValidatorVeryLowStakeThreshold.String(),
+		ValidatorLowStakeGracePeriod:          epoch.SystemParameters.ValidatorLowStakeGracePeriod.String(),
+		StakeSubsidyBalance:                   epoch.SystemStakeSubsidy.Balance.String(),
+		StakeSubsidyDistributionCounter:       epoch.SystemStakeSubsidy.DistributionCounter.String(),
+		StakeSubsidyCurrentDistributionAmount: epoch.SystemStakeSubsidy.CurrentDistributionAmount.String(),
+		StakeSubsidyPeriodLength:              epoch.SystemStakeSubsidy.PeriodLength.String(),
+		StakeSubsidyDecreaseRate:              epoch.SystemStakeSubsidy.DecreaseRate,
+		TotalStake:                            epoch.ValidatorSet.TotalStake.String(),
+		PendingActiveValidatorsID:             epoch.ValidatorSet.PendingActiveValidatorsID,
+		PendingActiveValidatorsSize:           epoch.ValidatorSet.PendingActiveValidatorsSize.String(),
+		StakingPoolMappingsID:                 epoch.ValidatorSet.StakingPoolMappingsID,
+		StakingPoolMappingsSize:               epoch.ValidatorSet.StakingPoolMappingsSize.String(),
+		InactivePoolsID:                       epoch.ValidatorSet.InactivePoolsID,
+		InactivePoolsSize:                     epoch.ValidatorSet.InactivePoolsSize.String(),
+		ValidatorCandidatesID:                 epoch.ValidatorSet.ValidatorCandidatesID,
+		ValidatorCandidatesSize:               epoch.ValidatorSet.ValidatorCandidatesSize.String(),
+		SafeMode:                              epoch.SafeMode.Enabled,
+		ActiveValidators:                      make(metrics.Validators, 0, len(validators)),
+		PendingRemovals:                       make([]interface{}, 0, len(epoch.ValidatorSet.PendingRemovals)),
+		AtRiskValidators:                      [][]interface{}{},
+		ValidatorReportRecords:                [][]interface{}{},
+	}
+
+	for _, removal := range epoch.ValidatorSet.PendingRemovals {
+		systemState.PendingRemovals = append(systemState.PendingRemovals, removal.String())
+	}
+
+	for idx := range validators {
+		node := &validators[idx]
+		address := node.Address.Address
+
+		systemState.ActiveValidators = append(systemState.ActiveValidators, convertValidator(node))
+
+		if epochsAtRisk := node.AtRisk.String(); epochsAtRisk != "" && epochsAtRisk != "0" {
+			systemState.AtRiskValidators = append(systemState.AtRiskValidators, []interface{}{address, epochsAtRisk})
+		}
+	}
+
+	systemState.ValidatorReportRecords = convertReportRecords(validators)
+
+	return toMap(systemState)
+}
+
+// convertReportRecords maps the report records of the validators into the JSON-RPC report records shape.
+// The report records of a validator hold the validators it reported, while JSON-RPC keys the records
+// by the reported validator with the validators that reported it, so the mapping is inverted.
+func convertReportRecords(validators []validator) [][]interface{} {
+	var (
+		reported  []string
+		reporters = make(map[string][]interface{})
+	)
+
+	for idx := range validators {
+		reporter := validators[idx].Address.Address
+
+		for _, record := range validators[idx].ReportRecords.Nodes {
+			address := record.Address.Address
+
+			if _, ok := reporters[address]; !ok {
+				reported = append(reported, address)
+			}
+
+			reporters[address] = append(reporters[address], reporter)
+		}
+	}
+
+	records := make([][]interface{}, 0, len(reported))
+	for _, address := range reported {
+		records = append(records, []interface{}{address, reporters[address]})
+	}
+
+	return records
+}
+
+// convertValidator maps a GraphQL validator into the JSON-RPC validator summary shape.
+func convertValidator(node *validator) *metrics.Validator {
+	result := &metrics.Validator{
+		SuiAddress:                 node.Address.Address,
+		Name:                       node.Name,
+		Description:                node.Description,
+		ImageURL:                   node.ImageURL,
+		ProjectURL:                 node.ProjectURL,
+		StakingPoolID:              node.StakingPoolID,
+		ExchangeRatesSize:          node.ExchangeRatesSize.String(),
+		StakingPoolActivationEpoch: node.StakingPoolActivationEpoch.String(),
+		StakingPoolSuiBalance:      node.StakingPoolSuiBalance.String(),
+		RewardsPool:                node.RewardsPool.String(),
+		PoolTokenBalance:           node.PoolTokenBalance.String(),
+		PendingStake:               node.PendingStake.String(),
+		PendingTotalSuiWithdraw:    node.PendingTotalSuiWithdraw.String(),
+		PendingPoolTokenWithdraw:   node.PendingPoolTokenWithdraw.String(),
+		VotingPower:                node.VotingPower.String(),
+		GasPrice:                   node.GasPrice.String(),
+		CommissionRate:             node.CommissionRate.String(),
+		NextEpochStake:             node.NextEpochStake.String(),
+		NextEpochGasPrice:          node.NextEpochGasPrice.String(),
+		NextEpochCommissionRate:    node.NextEpochCommissionRate.String(),
+	}
+
+	if node.OperationCap != nil {
+		result.OperationCapID = node.OperationCap.Address
+	}
+
+	if node.ExchangeRates != nil {
+		result.ExchangeRatesID = node.ExchangeRates.Address
+	}
+
+	if current := node.Credentials; current != nil {
+		result.ProtocolPubkeyBytes = stringValue(current.ProtocolPubKey)
+		result.NetworkPubkeyBytes = stringValue(current.NetworkPubKey)
+		result.WorkerPubkeyBytes = stringValue(current.WorkerPubKey)
+		result.ProofOfPossessionBytes = stringValue(current.ProofOfPossession)
+		result.NetAddress = stringValue(current.NetAddress)
+		result.P2PAddress = stringValue(current.P2PAddress)
+		result.PrimaryAddress = stringValue(current.PrimaryAddress)
+		result.WorkerAddress = stringValue(current.WorkerAddress)
+	}
+
+	// The next epoch values are set only if they are pending, so they are left nil the same way JSON-RPC omits them.
+	if next := node.NextEpochCredentials; next != nil {
+		nextEpochValues := []struct {
+			value  *string
+			target *interface{}
+		}{
+			{next.ProtocolPubKey, &result.NextEpochProtocolPubkeyBytes},
+			{next.NetworkPubKey, &result.NextEpochNetworkPubkeyBytes},
+			{next.WorkerPubKey, &result.NextEpochWorkerPubkeyBytes},
+			{next.ProofOfPossession, &result.NextEpochProofOfPossession},
+			{next.NetAddress, &result.NextEpochNetAddress},
+			{next.P2PAddress, &result.NextEpochP2PAddress},
+			{next.PrimaryAddress, &result.NextEpochPrimaryAddress},
+			{next.WorkerAddress, &result.NextEpochWorkerAddress},
+		}
+
+		for _, nextEpochValue := range nextEpochValues {
+			if nextEpochValue.value != nil {
+				*nextEpochValue.target = *nextEpochValue.value
+			}
+		}
+	}
+
+	return result
+}
+
+// stringValue returns the value of the nullable string, or an empty string if it is null.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// convertProtocolConfig maps the GraphQL protocol config into the JSON-RPC protocol config shape.
+// The GraphQL service does not expose the supported protocol versions range, so those values are set to NotAvailable.
+func convertProtocolConfig(data *protocolConfigData) map[string]interface{} {
+	config := data.ProtocolConfig
+
+	featureFlags := make(map[string]interface{}, len(config.FeatureFlags))
+	for _, flag := range config.FeatureFlags {
+		featureFlags[flag.Key] = flag.Value
+	}
+
+	attributes := make(map[string]interface{}, len(config.Configs))
+	for _, attribute := range config.Configs {
+		attributes[attribute.Key] = convertAttribute(attribute.Value)
+	}
+
+	return map[string]interface{}{
+		"minSupportedProtocolVersion": metrics.NotAvailable,
+		"maxSupportedProtocolVersion": metrics.NotAvailable,
+		"protocolVersion":             config.ProtocolVersion.String(),
+		"featureFlags":                featureFlags,
+		"attributes":                  attributes,
+	}
+}
+
+// convertAttribute wraps a protocol config value into the typed JSON-RPC representation.
+// GraphQL returns all the values as strings, so the type is inferred from the value itself.
+func convertAttribute(value any) any {
+	valueString, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	if _, err := strconv.ParseUint(valueString, 10, 64); err == nil {
+		return map[string]interface{}{"u64": valueString}
+	}
+
+	if _, err := strconv.ParseFloat(valueString, 64); err == nil {
+		return map[string]interface{}{"f64": valueString}
+	}
+
+	if valueBool, err := strconv.ParseBool(valueString); err == nil {
+		return map[string]interface{}{"bool": valueBool}
+	}
+
+	return map[string]interface{}{"string": valueString}
+}
+
+// convertApy converts the APY in basis points returned by GraphQL into the fraction returned by JSON-RPC.
+func convertApy(apy scalar) (float64, error) {
+	if apy == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(apy.String(), 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected validator apy value: %s", apy)
+	}
+
+	return value / apyBasisPoints, nil
+}
+
+// toMap converts the value into a generic map through its JSON representation.
+func toMap(value any) (map[string]interface{}, error) {
+	dataBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(dataBytes, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,354 @@
+package graphqlgw
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const validatorsPageSize = 50
+
+const (
+	queryCheckpoint = `
+query {
+  checkpoint {
+    sequenceNumber
+    networkTotalTransactions
+  }
+}`
+
+	queryProtocolConfig = `
+query ($version: UInt53) {
+  protocolConfig(protocolVersion: $version) {
+    protocolVersion
+    featureFlags { key value }
+    configs { key value }
+  }
+}`
+
+	queryValidatorsApy = `
+query ($first: Int, $after: String) {
+  epoch {
+    validatorSet {
+      activeValidators(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          address { address }
+          apy
+        }
+      }
+    }
+  }
+}`
+
+	// queryReportRecords fetches the next page of the report records of the validator at the position $validators - 1
+	// of the page of the active validators starting after $validatorsAfter.
+	queryReportRecords = `
+query ($validators: Int, $validatorsAfter: String, $first: Int, $after: String) {
+  epoch {
+    validatorSet {
+      activeValidators(first: $validators, after: $validatorsAfter) {
+        nodes {
+          address { address }
+          reportRecords(first: $first, after: $after) {
+            pageInfo { hasNextPage endCursor }
+            nodes { address { address } }
+          }
+        }
+      }
+    }
+  }
+}`
+
+	querySystemState = `
+query ($first: Int, $after: String) {
+  epoch {
+    epochId
+    referenceGasPrice
+    startTimestamp
+    systemStateVersion
+    protocolConfigs { protocolVersion }
+    storageFund { totalObjectStorageRebates nonRefundableBalance }
+    safeMode {
+      enabled
+      gasSummary { computationCost storageCost storageRebate nonRefundableStorageFee }
+    }
+    systemParameters {
+      durationMs
+      stakeSubsidyStartEpoch
+      maxValidatorCount
+      minValidatorJoiningStake
+      validatorLowStakeThreshold
+      validatorVeryLowStakeThreshold
+      validatorLowStakeGracePeriod
+    }
+    systemStakeSubsidy { balance distributionCounter currentDistributionAmount periodLength decreaseRate }
+    validatorSet {
+      totalStake
+      pendingRemovals
+      pendingActiveValidatorsId
+      pendingActiveValidatorsSize
+      stakingPoolMappingsId
+      stakingPoolMappingsSize
+      inactivePoolsId
+      inactivePoolsSize
+      validatorCandidatesId
+      validatorCandidatesSize
+      activeValidators(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          name
+          description
+          imageUrl
+          projectUrl
+          address { address }
+          credentials { ...CredentialsFields }
+          nextEpochCredentials { ...CredentialsFields }
+          operationCap { address }
+          stakingPoolId
+          exchangeRates { address }
+          exchangeRatesSize
+          stakingPoolActivationEpoch
+          stakingPoolSuiBalance
+          rewardsPool
+          poolTokenBalance
+          pendingStake
+          pendingTotalSuiWithdraw
+          pendingPoolTokenWithdraw
+          votingPower
+          gasPrice
+          commissionRate
+          nextEpochStake
+          nextEpochGasPrice
+          nextEpochCommissionRate
+          atRisk
+          reportRecords(first: $first) {
+            pageInfo { hasNextPage endCursor }
+            nodes { address { address } }
+          }
+        }
+      }
+    }
+  }
+}
+
+fragment CredentialsFields on ValidatorCredentials {
+  protocolPubKey
+  networkPubKey
+  workerPubKey
+  proofOfPossession
+  netAddress
+  p2PAddress
+  primaryAddress
+  workerAddress
+}`
+)
+
+type (
+	request struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables,omitempty"`
+	}
+
+	response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	// scalar holds a GraphQL scalar value as a string.
+	// Integer scalars are encoded either as JSON numbers or strings depending on their size.
+	scalar string
+
+	pageInfo struct {
+		EndCursor   string `json:"endCursor"`
+		HasNextPage bool   `json:"hasNextPage"`
+	}
+
+	objectRef struct {
+		Address string `json:"address"`
+	}
+
+	keyValue struct {
+		Key   string `json:"key"`
+		Value any    `json:"value"`
+	}
+
+	checkpointData struct {
+		Checkpoint struct {
+			SequenceNumber           scalar `json:"sequenceNumber"`
+			NetworkTotalTransactions scalar `json:"networkTotalTransactions"`
+		} `json:"checkpoint"`
+	}
+
+	protocolConfigData struct {
+		ProtocolConfig struct {
+			ProtocolVersion scalar     `json:"protocolVersion"`
+			FeatureFlags    []keyValue `json:"featureFlags"`
+			Configs         []keyValue `json:"configs"`
+		} `json:"protocolConfig"`
+	}
+
+	validatorsApyData struct {
+		Epoch struct {
+			ValidatorSet struct {
+				ActiveValidators struct {
+					PageInfo pageInfo `json:"pageInfo"`
+					Nodes    []struct {
+						Address objectRef `json:"address"`
+						Apy     scalar    `json:"apy"`
+					} `json:"nodes"`
+				} `json:"activeValidators"`
+			} `json:"validatorSet"`
+		} `json:"epoch"`
+	}
+
+	// credentials keeps the keys and the addresses of a validator, which are null if not set.
+	credentials struct {
+		ProtocolPubKey    *string `json:"protocolPubKey"`
+		NetworkPubKey     *string `json:"networkPubKey"`
+		WorkerPubKey      *string `json:"workerPubKey"`
+		ProofOfPossession *string `json:"proofOfPossession"`
+		NetAddress        *string `json:"netAddress"`
+		P2PAddress        *string `json:"p2PAddress"`
+		PrimaryAddress    *string `json:"primaryAddress"`
+		WorkerAddress     *string `json:"workerAddress"`
+	}
+
+	validator struct {
+		Name                       string        `json:"name"`
+		Description                string        `json:"description"`
+		ImageURL                   string        `json:"imageUrl"`
+		ProjectURL                 string        `json:"projectUrl"`
+		Address                    objectRef     `json:"address"`
+		Credentials                *credentials  `json:"credentials"`
+		NextEpochCredentials       *credentials  `json:"nextEpochCredentials"`
+		OperationCap               *objectRef    `json:"operationCap"`
+		StakingPoolID              string        `json:"stakingPoolId"`
+		ExchangeRates              *objectRef    `json:"exchangeRates"`
+		ExchangeRatesSize          scalar        `json:"exchangeRatesSize"`
+		StakingPoolActivationEpoch scalar        `json:"stakingPoolActivationEpoch"`
+		StakingPoolSuiBalance      scalar        `json:"stakingPoolSuiBalance"`
+		RewardsPool                scalar        `json:"rewardsPool"`
+		PoolTokenBalance           scalar        `json:"poolTokenBalance"`
+		PendingStake               scalar        `json:"pendingStake"`
+		PendingTotalSuiWithdraw    scalar        `json:"pendingTotalSuiWithdraw"`
+		PendingPoolTokenWithdraw   scalar        `json:"pendingPoolTokenWithdraw"`
+		VotingPower                scalar        `json:"votingPower"`
+		GasPrice                   scalar        `json:"gasPrice"`
+		CommissionRate             scalar        `json:"commissionRate"`
+		NextEpochStake             scalar        `json:"nextEpochStake"`
+		NextEpochGasPrice          scalar        `json:"nextEpochGasPrice"`
+		NextEpochCommissionRate    scalar        `json:"nextEpochCommissionRate"`
+		AtRisk                     scalar        `json:"atRisk"`
+		ReportRecords              reportRecords `json:"reportRecords"`
+	}
+
+	// reportRecords holds the validators reported by a validator.
+	reportRecords struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			Address objectRef `json:"address"`
+		} `json:"nodes"`
+	}
+
+	reportRecordsData struct {
+		Epoch struct {
+			ValidatorSet struct {
+				ActiveValidators struct {
+					Nodes []struct {
+						Address       objectRef     `json:"address"`
+						ReportRecords reportRecords `json:"reportRecords"`
+					} `json:"nodes"`
+				} `json:"activeValidators"`
+			} `json:"validatorSet"`
+		} `json:"epoch"`
+	}
+
+	systemStateData struct {
+		Epoch struct {
+			EpochID            scalar `json:"epochId"`
+			ReferenceGasPrice  scalar `json:"referenceGasPrice"`
+			StartTimestamp     string `json:"startTimestamp"`
+			SystemStateVersion scalar `json:"systemStateVersion"`
+			ProtocolConfigs    struct {
+				ProtocolVersion scalar `json:"protocolVersion"`
+			} `json:"protocolConfigs"`
+			StorageFund struct {
+				TotalObjectStorageRebates scalar `json:"totalObjectStorageRebates"`
+				NonRefundableBalance      scalar `json:"nonRefundableBalance"`
+			} `json:"storageFund"`
+			SafeMode struct {
+				Enabled    bool `json:"enabled"`
+				GasSummary struct {
+					ComputationCost         scalar `json:"computationCost"`
+					StorageCost             scalar `json:"storageCost"`
+					StorageRebate           scalar `json:"storageRebate"`
+					NonRefundableStorageFee scalar `json:"nonRefundableStorageFee"`
+				} `json:"gasSummary"`
+			} `json:"safeMode"`
+			SystemParameters struct {
+				DurationMs                     scalar `json:"durationMs"`
+				StakeSubsidyStartEpoch         scalar `json:"stakeSubsidyStartEpoch"`
+				MaxValidatorCount              scalar `json:"maxValidatorCount"`
+				MinValidatorJoiningStake       scalar `json:"minValidatorJoiningStake"`
+				ValidatorLowStakeThreshold     scalar `json:"validatorLowStakeThreshold"`
+				ValidatorVeryLowStakeThreshold scalar `json:"validatorVeryLowStakeThreshold"`
+				ValidatorLowStakeGracePeriod   scalar `json:"validatorLowStakeGracePeriod"`
+			} `json:"systemParameters"`
+			SystemStakeSubsidy struct {
+				Balance                   scalar `json:"balance"`
+				DistributionCounter       scalar `json:"distributionCounter"`
+				CurrentDistributionAmount scalar `json:"currentDistributionAmount"`
+				PeriodLength              scalar `json:"periodLength"`
+				DecreaseRate              int    `json:"decreaseRate"`
+			} `json:"systemStakeSubsidy"`
+			ValidatorSet struct {
+				TotalStake                  scalar   `json:"totalStake"`
+				PendingRemovals             []scalar `json:"pendingRemovals"`
+				PendingActiveValidatorsID   string   `json:"pendingActiveValidatorsId"`
+				PendingActiveValidatorsSize scalar   `json:"pendingActiveValidatorsSize"`
+				StakingPoolMappingsID       string   `json:"stakingPoolMappingsId"`
+				StakingPoolMappingsSize     scalar   `json:"stakingPoolMappingsSize"`
+				InactivePoolsID             string   `json:"inactivePoolsId"`
+				InactivePoolsSize           scalar   `json:"inactivePoolsSize"`
+				ValidatorCandidatesID       string   `json:"validatorCandidatesId"`
+				ValidatorCandidatesSize     scalar   `json:"validatorCandidatesSize"`
+				ActiveValidators            struct {
+					PageInfo pageInfo    `json:"pageInfo"`
+					Nodes    []validator `json:"nodes"`
+				} `json:"activeValidators"`
+			} `json:"validatorSet"`
+		} `json:"epoch"`
+	}
+)
+
+// UnmarshalJSON decodes a scalar given as a JSON string, number or null.
+func (s *scalar) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*s = ""
+
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		*s = scalar(value)
+
+		return nil
+	}
+
+	*s = scalar(data)
+
+	return nil
+}
+
+func (s scalar) String() string {
+	return string(s)
+}
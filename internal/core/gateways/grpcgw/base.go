@@ -0,0 +1,91 @@
+package grpcgw
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/latency"
+)
+
+const (
+	grpcClientTimeout = 3 * time.Second
+
+	portHTTP  = "80"
+	portHTTPS = "443"
+)
+
+// Gateway implements ports.RPCGateway on top of the sui-node gRPC API.
+// The results are converted into the same shapes returned by the JSON-RPC API.
+type Gateway struct {
+	conn       *grpc.ClientConn
+	connErr    error
+	cliGateway *cligw.Gateway
+	latency    *latency.Tracker
+	url        string
+}
+
+// NewGateway creates a gateway for the gRPC API served at the given URL.
+// TLS is used for https URLs. Additional dial options can be provided, e.g. to connect to an in-process server.
+func NewGateway(cliGW *cligw.Gateway, url string, opts ...grpc.DialOption) ports.RPCGateway {
+	gateway := &Gateway{
+		url:        url,
+		cliGateway: cliGW,
+		latency:    latency.NewTracker(),
+	}
+
+	target, secure, err := parseTarget(url)
+	if err != nil {
+		gateway.connErr = err
+
+		return gateway
+	}
+
+	transport := insecure.NewCredentials()
+	if secure {
+		transport = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transport),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	}
+
+	gateway.conn, gateway.connErr = grpc.NewClient(target, append(dialOpts, opts...)...)
+
+	return gateway
+}
+
+// Latency returns the round-trip time statistics for the calls made through the gateway.
+func (gateway *Gateway) Latency() latency.Stats {
+	return gateway.latency.Stats()
+}
+
+// parseTarget converts the endpoint URL into a gRPC target and reports whether TLS should be used.
+func parseTarget(rawURL string) (target string, secure bool, err error) {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid grpc address %s: %w", rawURL, err)
+	}
+
+	secure = endpoint.Scheme == "https"
+
+	if endpoint.Port() != "" {
+		return endpoint.Host, secure, nil
+	}
+
+	port := portHTTP
+	if secure {
+		port = portHTTPS
+	}
+
+	return net.JoinHostPort(endpoint.Hostname(), port), secure, nil
+}
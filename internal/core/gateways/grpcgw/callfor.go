@@ -0,0 +1,74 @@
+package grpcgw
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+var (
+	checkpointReadMask = []string{"sequence_number", "summary.total_network_transactions"}
+	epochReadMask      = []string{"epoch", "reference_gas_price", "system_state"}
+)
+
+// CallFor fetches the data for the specified RPC method from the gRPC API.
+// The result is returned in the same shape as the JSON-RPC API returns it for the method,
+// so it can be consumed by the existing metrics parsers.
+// The call is canceled when the provided context is done or the client timeout expires.
+func (gateway *Gateway) CallFor(ctx context.Context, method enums.RPCMethod, _ ...interface{}) (any, error) {
+	if gateway.connErr != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", gateway.connErr)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, grpcClientTimeout)
+	defer cancel()
+
+	//nolint:exhaustive // only the methods served by the gRPC API are supported
+	switch method {
+	case enums.RPCMethodGetLatestCheckpointSequenceNumber:
+		var response getServiceInfoResponse
+		if err := gateway.invoke(ctx, methodGetServiceInfo, getServiceInfoRequest{}, &response); err != nil {
+			return nil, err
+		}
+
+		return strconv.FormatUint(response.CheckpointHeight, 10), nil
+	case enums.RPCMethodGetTotalTransactionBlocks:
+		var response getCheckpointResponse
+		if err := gateway.invoke(ctx, methodGetCheckpoint, getCheckpointRequest{ReadMask: checkpointReadMask}, &response); err != nil {
+			return nil, err
+		}
+
+		return strconv.FormatUint(response.Checkpoint.Summary.TotalNetworkTransactions, 10), nil
+	case enums.RPCMethodGetSuiSystemState:
+		var response getEpochResponse
+		if err := gateway.invoke(ctx, methodGetEpoch, getEpochRequest{ReadMask: epochReadMask}, &response); err != nil {
+			return nil, err
+		}
+
+		if response.Epoch.SystemState == nil {
+			return nil, fmt.Errorf("grpc response for %s contains no system state", methodGetEpoch)
+		}
+
+		return convertSystemState(response.Epoch.SystemState)
+	default:
+		return nil, fmt.Errorf("unsupported grpc method: %s", method)
+	}
+}
+
+// invoke makes a unary gRPC call and records its round-trip time.
+func (gateway *Gateway) invoke(ctx context.Context, method string, request marshaler, response unmarshaler) error {
+	start := time.Now()
+
+	err := gateway.conn.Invoke(ctx, method, request, response)
+
+	gateway.latency.Since(start)
+
+	if err != nil {
+		return fmt.Errorf("failed to get response from grpc client: %w", err)
+	}
+
+	return nil
+}
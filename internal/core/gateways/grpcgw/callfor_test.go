@@ -0,0 +1,206 @@
+package grpcgw
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// rawCodec passes the encoded messages through, so the stub server can serve hand-encoded responses.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	data, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("unsupported message: %T", v)
+	}
+
+	return *data, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	message, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unsupported message: %T", v)
+	}
+
+	*message = append((*message)[:0], data...)
+
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// stubLedgerService serves the LedgerService methods used by the gateway with fixed responses.
+func stubLedgerService(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+
+	var request []byte
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+
+	var response []byte
+
+	switch method {
+	case methodGetServiceInfo:
+		response = appendString(response, 1, "4c78adac")
+		response = appendVarint(response, 3, 512)
+		response = appendVarint(response, 4, 98765)
+	case methodGetCheckpoint:
+		summary := appendVarint(nil, 3, 512)
+		summary = appendVarint(summary, 4, 98765)
+		summary = appendVarint(summary, 5, 3456789)
+
+		checkpoint := appendVarint(nil, 1, 98765)
+		checkpoint = appendMessage(checkpoint, 3, summary)
+
+		response = appendMessage(response, 1, checkpoint)
+	case methodGetEpoch:
+		response = appendMessage(response, 1, stubEpoch())
+	default:
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	return stream.SendMsg(&response)
+}
+
+// stubEpoch encodes an epoch with a system state holding a single active validator.
+func stubEpoch() []byte {
+	pool := appendString(nil, 1, "0xpool")
+	pool = appendVarint(pool, 4, 30_000_000)
+
+	node := appendString(nil, 1, "validator-a")
+	node = appendString(node, 2, "0xa")
+	node = appendVarint(node, 27, 10000)
+	node = appendMessage(node, 30, pool)
+	node = appendVarint(node, 32, 30_000_000)
+
+	validators := appendVarint(nil, 1, 30_000_000)
+	validators = appendMessage(validators, 2, node)
+
+	state := appendVarint(nil, 2, 512)
+	state = appendVarint(state, 3, 80)
+	state = appendMessage(state, 4, validators)
+	state = appendVarint(state, 7, 750)
+
+	epoch := appendVarint(nil, 1, 512)
+	epoch = appendMessage(epoch, 3, state)
+
+	return appendVarint(epoch, 8, 750)
+}
+
+// appendVarint appends a varint field to the encoded message.
+func appendVarint(data []byte, number protowire.Number, value uint64) []byte {
+	data = protowire.AppendTag(data, number, protowire.VarintType)
+
+	return protowire.AppendVarint(data, value)
+}
+
+// newStubGateway starts the stub server on an in-memory listener and connects a gateway to it.
+func newStubGateway(t *testing.T) *Gateway {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(rawCodec{}), grpc.UnknownServiceHandler(stubLedgerService))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}
+
+	gateway, ok := NewGateway(nil, "http://localhost", grpc.WithContextDialer(dialer)).(*Gateway)
+	if !ok {
+		t.Fatal("NewGateway did not return a grpc gateway")
+	}
+
+	t.Cleanup(func() {
+		if gateway.conn != nil {
+			_ = gateway.conn.Close()
+		}
+	})
+
+	return gateway
+}
+
+// TestCallFor checks that the gRPC responses are converted into the JSON-RPC shapes.
+func TestCallFor(t *testing.T) {
+	gateway := newStubGateway(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		method enums.RPCMethod
+		want   string
+	}{
+		{method: enums.RPCMethodGetLatestCheckpointSequenceNumber, want: "98765"},
+		{method: enums.RPCMethodGetTotalTransactionBlocks, want: "3456789"},
+	}
+
+	for _, tt := range tests {
+		result, err := gateway.CallFor(ctx, tt.method)
+		if err != nil {
+			t.Fatalf("CallFor(%s) error = %v", tt.method, err)
+		}
+
+		if result != tt.want {
+			t.Errorf("CallFor(%s) = %v, want %s", tt.method, result, tt.want)
+		}
+	}
+
+	result, err := gateway.CallFor(ctx, enums.RPCMethodGetSuiSystemState)
+	if err != nil {
+		t.Fatalf("CallFor(%s) error = %v", enums.RPCMethodGetSuiSystemState, err)
+	}
+
+	systemState, ok := result.(map[string]interface{})
+	if !ok {
+		t.Fatalf("CallFor(%s) = %T, want map", enums.RPCMethodGetSuiSystemState, result)
+	}
+
+	for key, want := range map[string]string{
+		"epoch":             "512",
+		"protocolVersion":   "80",
+		"referenceGasPrice": "750",
+		"totalStake":        "30000000",
+	} {
+		if got := systemState[key]; got != want {
+			t.Errorf("system state %s = %v, want %s", key, got, want)
+		}
+	}
+
+	activeValidators, ok := systemState["activeValidators"].([]interface{})
+	if !ok || len(activeValidators) != 1 {
+		t.Fatalf("system state activeValidators = %v, want one validator", systemState["activeValidators"])
+	}
+
+	validator, _ := activeValidators[0].(map[string]interface{})
+
+	for key, want := range map[string]string{
+		"name":                  "validator-a",
+		"suiAddress":            "0xa",
+		"votingPower":           "10000",
+		"nextEpochStake":        "30000000",
+		"stakingPoolId":         "0xpool",
+		"stakingPoolSuiBalance": "30000000",
+	} {
+		if got := validator[key]; got != want {
+			t.Errorf("validator %s = %v, want %s", key, got, want)
+		}
+	}
+}
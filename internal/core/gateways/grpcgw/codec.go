@@ -0,0 +1,128 @@
+package grpcgw
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type (
+	// marshaler is implemented by the request messages sent to the gRPC API.
+	marshaler interface {
+		marshal() []byte
+	}
+
+	// unmarshaler is implemented by the response messages returned by the gRPC API.
+	unmarshaler interface {
+		unmarshal(data []byte) error
+	}
+
+	// codec encodes the hand-written request and response messages using the protobuf wire format.
+	// Only the fields used by suimon are decoded, all the other fields are skipped.
+	codec struct{}
+
+	// field represents a single decoded protobuf field.
+	field struct {
+		bytes  []byte
+		varint uint64
+		number protowire.Number
+		typ    protowire.Type
+	}
+)
+
+func (codec) Marshal(v any) ([]byte, error) {
+	message, ok := v.(marshaler)
+	if !ok {
+		return nil, fmt.Errorf("unsupported grpc request message: %T", v)
+	}
+
+	return message.marshal(), nil
+}
+
+func (codec) Unmarshal(data []byte, v any) error {
+	message, ok := v.(unmarshaler)
+	if !ok {
+		return fmt.Errorf("unsupported grpc response message: %T", v)
+	}
+
+	return message.unmarshal(data)
+}
+
+func (codec) Name() string {
+	return "proto"
+}
+
+// walkFields decodes the encoded message and calls the visitor for every field in it.
+func walkFields(data []byte, visit func(field field) error) error {
+	for len(data) > 0 {
+		number, typ, tagLen := protowire.ConsumeTag(data)
+		if tagLen < 0 {
+			return protowire.ParseError(tagLen)
+		}
+
+		data = data[tagLen:]
+
+		current := field{number: number, typ: typ}
+
+		var valueLen int
+
+		switch typ {
+		case protowire.VarintType:
+			current.varint, valueLen = protowire.ConsumeVarint(data)
+		case protowire.BytesType:
+			current.bytes, valueLen = protowire.ConsumeBytes(data)
+		default:
+			valueLen = protowire.ConsumeFieldValue(number, typ, data)
+		}
+
+		if valueLen < 0 {
+			return protowire.ParseError(valueLen)
+		}
+
+		data = data[valueLen:]
+
+		if err := visit(current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uint64s decodes a repeated uint64 field given either packed or as a single value.
+func (f field) uint64s() ([]uint64, error) {
+	if f.typ == protowire.VarintType {
+		return []uint64{f.varint}, nil
+	}
+
+	var (
+		values []uint64
+		data   = f.bytes
+	)
+
+	for len(data) > 0 {
+		value, valueLen := protowire.ConsumeVarint(data)
+		if valueLen < 0 {
+			return nil, protowire.ParseError(valueLen)
+		}
+
+		values = append(values, value)
+		data = data[valueLen:]
+	}
+
+	return values, nil
+}
+
+// appendString appends a string field to the encoded message.
+func appendString(data []byte, number protowire.Number, value string) []byte {
+	data = protowire.AppendTag(data, number, protowire.BytesType)
+
+	return protowire.AppendString(data, value)
+}
+
+// appendMessage appends an embedded message field to the encoded message.
+func appendMessage(data []byte, number protowire.Number, value []byte) []byte {
+	data = protowire.AppendTag(data, number, protowire.BytesType)
+
+	return protowire.AppendBytes(data, value)
+}
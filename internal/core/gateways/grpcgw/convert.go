@@ -0,0 +1,177 @@
+package grpcgw
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// convertSystemState maps the gRPC system state into the JSON-RPC system state shape.
+func convertSystemState(state *systemState) (map[string]interface{}, error) {
+	validators := state.Validators
+
+	systemState := metrics.SuiSystemState{
+		Epoch:                                 formatUint(state.Epoch),
+		ProtocolVersion:                       formatUint(state.ProtocolVersion),
+		SystemStateVersion:                    formatUint(state.Version),
+		StorageFundTotalObjectStorageRebates:  formatUint(state.StorageFund.TotalObjectStorageRebates),
+		StorageFundNonRefundableBalance:       formatUint(state.StorageFund.NonRefundableBalance),
+		ReferenceGasPrice:                     formatUint(state.ReferenceGasPrice),
+		SafeModeStorageRewards:                formatUint(state.SafeModeStorageRewards),
+		SafeModeComputationRewards:            formatUint(state.SafeModeComputationRewards),
+		SafeModeStorageRebates:                formatUint(state.SafeModeStorageRebates),
+		SafeModeNonRefundableStorageFee:       formatUint(state.SafeModeNonRefundableStorageFee),
+		EpochStartTimestampMs:                 formatUint(state.EpochStartTimestampMs),
+		EpochDurationMs:                       formatUint(state.Parameters.EpochDurationMs),
+		StakeSubsidyStartEpoch:                formatUint(state.Parameters.StakeSubsidyStartEpoch),
+		MaxValidatorCount:                     formatUint(state.Parameters.MaxValidatorCount),
+		MinValidatorJoiningStake:              formatUint(state.Parameters.MinValidatorJoiningStake),
+		ValidatorLowStakeThreshold:            formatUint(state.Parameters.ValidatorLowStakeThreshold),
+		ValidatorVeryLowStakeThreshold:        formatUint(state.Parameters.ValidatorVeryLowStakeThreshold),
+		ValidatorLowStakeGracePeriod:          formatUint(state.Parameters.ValidatorLowStakeGracePeriod),
+		StakeSubsidyBalance:                   formatUint(state.StakeSubsidy.Balance),
+		StakeSubsidyDistributionCounter:       formatUint(state.StakeSubsidy.DistributionCounter),
+		StakeSubsidyCurrentDistributionAmount: formatUint(state.StakeSubsidy.CurrentDistributionAmount),
+		StakeSubsidyPeriodLength:              formatUint(state.StakeSubsidy.PeriodLength),
+		StakeSubsidyDecreaseRate:              int(state.StakeSubsidy.DecreaseRate),
+		TotalStake:                            formatUint(validators.TotalStake),
+		PendingActiveValidatorsID:             validators.PendingActiveValidators.ID,
+		PendingActiveValidatorsSize:           formatUint(validators.PendingActiveValidators.Size),
+		StakingPoolMappingsID:                 validators.StakingPoolMappings.ID,
+		StakingPoolMappingsSize:               formatUint(validators.StakingPoolMappings.Size),
+		InactivePoolsID:                       validators.InactiveValidators.ID,
+		InactivePoolsSize:                     formatUint(validators.InactiveValidators.Size),
+		ValidatorCandidatesID:                 validators.ValidatorCandidates.ID,
+		ValidatorCandidatesSize:               formatUint(validators.ValidatorCandidates.Size),
+		SafeMode:                              state.SafeMode,
+		ActiveValidators:                      make(metrics.Validators, 0, len(validators.ActiveValidators)),
+		PendingRemovals:                       make([]interface{}, 0, len(validators.PendingRemovals)),
+		AtRiskValidators:                      make([][]interface{}, 0, len(validators.AtRiskValidators)),
+		ValidatorReportRecords:                make([][]interface{}, 0, len(state.ValidatorReportRecords)),
+	}
+
+	for idx := range validators.ActiveValidators {
+		systemState.ActiveValidators = append(systemState.ActiveValidators, convertValidator(&validators.ActiveValidators[idx]))
+	}
+
+	for _, removal := range validators.PendingRemovals {
+		systemState.PendingRemovals = append(systemState.PendingRemovals, formatUint(removal))
+	}
+
+	for address, epochs := range validators.AtRiskValidators {
+		systemState.AtRiskValidators = append(systemState.AtRiskValidators, []interface{}{address, formatUint(epochs)})
+	}
+
+	for _, record := range state.ValidatorReportRecords {
+		reporters := make([]interface{}, 0, len(record.Reporters))
+		for _, reporter := range record.Reporters {
+			reporters = append(reporters, reporter)
+		}
+
+		systemState.ValidatorReportRecords = append(systemState.ValidatorReportRecords, []interface{}{record.Reported, reporters})
+	}
+
+	return toMap(systemState)
+}
+
+// convertValidator maps a gRPC validator into the JSON-RPC validator summary shape.
+func convertValidator(node *validator) *metrics.Validator {
+	pool := node.StakingPool
+
+	result := &metrics.Validator{
+		SuiAddress:                 node.Address,
+		ProtocolPubkeyBytes:        encodeBytes(node.ProtocolPublicKey),
+		NetworkPubkeyBytes:         encodeBytes(node.NetworkPublicKey),
+		WorkerPubkeyBytes:          encodeBytes(node.WorkerPublicKey),
+		ProofOfPossessionBytes:     encodeBytes(node.ProofOfPossession),
+		Name:                       node.Name,
+		Description:                node.Description,
+		ImageURL:                   node.ImageURL,
+		ProjectURL:                 node.ProjectURL,
+		NetAddress:                 node.NetworkAddress,
+		P2PAddress:                 node.P2PAddress,
+		PrimaryAddress:             node.PrimaryAddress,
+		WorkerAddress:              node.WorkerAddress,
+		VotingPower:                formatUint(node.VotingPower),
+		OperationCapID:             node.OperationCapID,
+		GasPrice:                   formatUint(node.GasPrice),
+		CommissionRate:             formatUint(node.CommissionRate),
+		NextEpochStake:             formatUint(node.NextEpochStake),
+		NextEpochGasPrice:          formatUint(node.NextEpochGasPrice),
+		NextEpochCommissionRate:    formatUint(node.NextEpochCommissionRate),
+		StakingPoolID:              pool.ID,
+		StakingPoolActivationEpoch: formatUint(pool.ActivationEpoch),
+		StakingPoolSuiBalance:      formatUint(pool.SuiBalance),
+		RewardsPool:                formatUint(pool.RewardsPool),
+		PoolTokenBalance:           formatUint(pool.PoolTokenBalance),
+		PendingStake:               formatUint(pool.PendingStake),
+		PendingTotalSuiWithdraw:    formatUint(pool.PendingTotalSuiWithdraw),
+		PendingPoolTokenWithdraw:   formatUint(pool.PendingPoolTokenWithdraw),
+		ExchangeRatesID:            pool.ExchangeRates.ID,
+		ExchangeRatesSize:          formatUint(pool.ExchangeRates.Size),
+	}
+
+	if pool.DeactivationEpoch != nil {
+		result.StakingPoolDeactivationEpoch = formatUint(*pool.DeactivationEpoch)
+	}
+
+	if node.NextEpochProtocolPublicKey != nil {
+		result.NextEpochProtocolPubkeyBytes = encodeBytes(node.NextEpochProtocolPublicKey)
+	}
+
+	if node.NextEpochProofOfPossession != nil {
+		result.NextEpochProofOfPossession = encodeBytes(node.NextEpochProofOfPossession)
+	}
+
+	if node.NextEpochNetworkPublicKey != nil {
+		result.NextEpochNetworkPubkeyBytes = encodeBytes(node.NextEpochNetworkPublicKey)
+	}
+
+	if node.NextEpochWorkerPublicKey != nil {
+		result.NextEpochWorkerPubkeyBytes = encodeBytes(node.NextEpochWorkerPublicKey)
+	}
+
+	if node.NextEpochNetworkAddress != nil {
+		result.NextEpochNetAddress = *node.NextEpochNetworkAddress
+	}
+
+	if node.NextEpochP2PAddress != nil {
+		result.NextEpochP2PAddress = *node.NextEpochP2PAddress
+	}
+
+	if node.NextEpochPrimaryAddress != nil {
+		result.NextEpochPrimaryAddress = *node.NextEpochPrimaryAddress
+	}
+
+	if node.NextEpochWorkerAddress != nil {
+		result.NextEpochWorkerAddress = *node.NextEpochWorkerAddress
+	}
+
+	return result
+}
+
+// encodeBytes encodes the raw bytes as base64 the same way the JSON-RPC API does.
+func encodeBytes(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
+
+// toMap converts the value into a generic map through its JSON representation.
+func toMap(value any) (map[string]interface{}, error) {
+	dataBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(dataBytes, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
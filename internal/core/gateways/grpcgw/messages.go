@@ -0,0 +1,563 @@
+package grpcgw
+
+// The messages below mirror the subset of the sui.rpc.v2 protobuf definitions used by suimon.
+// Field numbers must match the upstream definitions in sui-rpc-api.
+const (
+	methodGetServiceInfo = "/sui.rpc.v2.LedgerService/GetServiceInfo"
+	methodGetCheckpoint  = "/sui.rpc.v2.LedgerService/GetCheckpoint"
+	methodGetEpoch       = "/sui.rpc.v2.LedgerService/GetEpoch"
+)
+
+type (
+	getServiceInfoRequest struct{}
+
+	getServiceInfoResponse struct {
+		ChainID          string
+		Chain            string
+		Server           string
+		Epoch            uint64
+		CheckpointHeight uint64
+	}
+
+	getCheckpointRequest struct {
+		ReadMask []string
+	}
+
+	getCheckpointResponse struct {
+		Checkpoint checkpoint
+	}
+
+	checkpoint struct {
+		Summary        checkpointSummary
+		SequenceNumber uint64
+	}
+
+	checkpointSummary struct {
+		Epoch                    uint64
+		SequenceNumber           uint64
+		TotalNetworkTransactions uint64
+	}
+
+	getEpochRequest struct {
+		ReadMask []string
+	}
+
+	getEpochResponse struct {
+		Epoch epoch
+	}
+
+	epoch struct {
+		SystemState       *systemState
+		Epoch             uint64
+		ReferenceGasPrice uint64
+	}
+
+	systemState struct {
+		Validators                      validatorSet
+		StorageFund                     storageFund
+		Parameters                      systemParameters
+		StakeSubsidy                    stakeSubsidy
+		ValidatorReportRecords          []validatorReportRecord
+		Version                         uint64
+		Epoch                           uint64
+		ProtocolVersion                 uint64
+		ReferenceGasPrice               uint64
+		SafeModeStorageRewards          uint64
+		SafeModeComputationRewards      uint64
+		SafeModeStorageRebates          uint64
+		SafeModeNonRefundableStorageFee uint64
+		EpochStartTimestampMs           uint64
+		SafeMode                        bool
+	}
+
+	validatorReportRecord struct {
+		Reported  string
+		Reporters []string
+	}
+
+	storageFund struct {
+		TotalObjectStorageRebates uint64
+		NonRefundableBalance      uint64
+	}
+
+	systemParameters struct {
+		EpochDurationMs                uint64
+		StakeSubsidyStartEpoch         uint64
+		MaxValidatorCount              uint64
+		MinValidatorJoiningStake       uint64
+		ValidatorLowStakeThreshold     uint64
+		ValidatorVeryLowStakeThreshold uint64
+		ValidatorLowStakeGracePeriod   uint64
+	}
+
+	stakeSubsidy struct {
+		Balance                   uint64
+		DistributionCounter       uint64
+		CurrentDistributionAmount uint64
+		PeriodLength              uint64
+		DecreaseRate              uint64
+	}
+
+	validatorSet struct {
+		PendingActiveValidators moveTable
+		StakingPoolMappings     moveTable
+		InactiveValidators      moveTable
+		ValidatorCandidates     moveTable
+		AtRiskValidators        map[string]uint64
+		ActiveValidators        []validator
+		PendingRemovals         []uint64
+		TotalStake              uint64
+	}
+
+	moveTable struct {
+		ID   string
+		Size uint64
+	}
+
+	validator struct {
+		StakingPool                stakingPool
+		Name                       string
+		Address                    string
+		Description                string
+		ImageURL                   string
+		ProjectURL                 string
+		NetworkAddress             string
+		P2PAddress                 string
+		PrimaryAddress             string
+		WorkerAddress              string
+		NextEpochNetworkAddress    *string
+		NextEpochP2PAddress        *string
+		NextEpochPrimaryAddress    *string
+		NextEpochWorkerAddress     *string
+		OperationCapID             string
+		ProtocolPublicKey          []byte
+		ProofOfPossession          []byte
+		NetworkPublicKey           []byte
+		WorkerPublicKey            []byte
+		NextEpochProtocolPublicKey []byte
+		NextEpochProofOfPossession []byte
+		NextEpochNetworkPublicKey  []byte
+		NextEpochWorkerPublicKey   []byte
+		VotingPower                uint64
+		GasPrice                   uint64
+		CommissionRate             uint64
+		NextEpochStake             uint64
+		NextEpochGasPrice          uint64
+		NextEpochCommissionRate    uint64
+	}
+
+	stakingPool struct {
+		ExchangeRates            moveTable
+		ID                       string
+		DeactivationEpoch        *uint64
+		ActivationEpoch          uint64
+		SuiBalance               uint64
+		RewardsPool              uint64
+		PoolTokenBalance         uint64
+		PendingStake             uint64
+		PendingTotalSuiWithdraw  uint64
+		PendingPoolTokenWithdraw uint64
+	}
+)
+
+func (getServiceInfoRequest) marshal() []byte {
+	return []byte{}
+}
+
+func (request getCheckpointRequest) marshal() []byte {
+	return appendMessage(nil, 3, marshalFieldMask(request.ReadMask))
+}
+
+func (request getEpochRequest) marshal() []byte {
+	return appendMessage(nil, 2, marshalFieldMask(request.ReadMask))
+}
+
+// marshalFieldMask encodes a google.protobuf.FieldMask with the given paths.
+func marshalFieldMask(paths []string) []byte {
+	var data []byte
+
+	for _, path := range paths {
+		data = appendString(data, 1, path)
+	}
+
+	return data
+}
+
+func (response *getServiceInfoResponse) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			response.ChainID = string(f.bytes)
+		case 2:
+			response.Chain = string(f.bytes)
+		case 3:
+			response.Epoch = f.varint
+		case 4:
+			response.CheckpointHeight = f.varint
+		case 8:
+			response.Server = string(f.bytes)
+		}
+
+		return nil
+	})
+}
+
+func (response *getCheckpointResponse) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		if f.number == 1 {
+			return response.Checkpoint.unmarshal(f.bytes)
+		}
+
+		return nil
+	})
+}
+
+func (message *checkpoint) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.SequenceNumber = f.varint
+		case 3:
+			return message.Summary.unmarshal(f.bytes)
+		}
+
+		return nil
+	})
+}
+
+func (message *checkpointSummary) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 3:
+			message.Epoch = f.varint
+		case 4:
+			message.SequenceNumber = f.varint
+		case 5:
+			message.TotalNetworkTransactions = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (response *getEpochResponse) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		if f.number == 1 {
+			return response.Epoch.unmarshal(f.bytes)
+		}
+
+		return nil
+	})
+}
+
+func (message *epoch) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.Epoch = f.varint
+		case 3:
+			message.SystemState = &systemState{}
+
+			return message.SystemState.unmarshal(f.bytes)
+		case 8:
+			message.ReferenceGasPrice = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *systemState) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.Version = f.varint
+		case 2:
+			message.Epoch = f.varint
+		case 3:
+			message.ProtocolVersion = f.varint
+		case 4:
+			return message.Validators.unmarshal(f.bytes)
+		case 5:
+			return message.StorageFund.unmarshal(f.bytes)
+		case 6:
+			return message.Parameters.unmarshal(f.bytes)
+		case 7:
+			message.ReferenceGasPrice = f.varint
+		case 8:
+			var record validatorReportRecord
+			if err := record.unmarshal(f.bytes); err != nil {
+				return err
+			}
+
+			message.ValidatorReportRecords = append(message.ValidatorReportRecords, record)
+		case 9:
+			return message.StakeSubsidy.unmarshal(f.bytes)
+		case 10:
+			message.SafeMode = f.varint != 0
+		case 11:
+			message.SafeModeStorageRewards = f.varint
+		case 12:
+			message.SafeModeComputationRewards = f.varint
+		case 13:
+			message.SafeModeStorageRebates = f.varint
+		case 14:
+			message.SafeModeNonRefundableStorageFee = f.varint
+		case 15:
+			message.EpochStartTimestampMs = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *validatorReportRecord) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.Reported = string(f.bytes)
+		case 2:
+			message.Reporters = append(message.Reporters, string(f.bytes))
+		}
+
+		return nil
+	})
+}
+
+func (message *storageFund) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.TotalObjectStorageRebates = f.varint
+		case 2:
+			message.NonRefundableBalance = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *systemParameters) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.EpochDurationMs = f.varint
+		case 2:
+			message.StakeSubsidyStartEpoch = f.varint
+		case 4:
+			message.MaxValidatorCount = f.varint
+		case 5:
+			message.MinValidatorJoiningStake = f.varint
+		case 6:
+			message.ValidatorLowStakeThreshold = f.varint
+		case 7:
+			message.ValidatorVeryLowStakeThreshold = f.varint
+		case 8:
+			message.ValidatorLowStakeGracePeriod = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *stakeSubsidy) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.Balance = f.varint
+		case 2:
+			message.DistributionCounter = f.varint
+		case 3:
+			message.CurrentDistributionAmount = f.varint
+		case 4:
+			message.PeriodLength = f.varint
+		case 5:
+			message.DecreaseRate = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *validatorSet) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.TotalStake = f.varint
+		case 2:
+			var activeValidator validator
+			if err := activeValidator.unmarshal(f.bytes); err != nil {
+				return err
+			}
+
+			message.ActiveValidators = append(message.ActiveValidators, activeValidator)
+		case 3:
+			return message.PendingActiveValidators.unmarshal(f.bytes)
+		case 4:
+			removals, err := f.uint64s()
+			if err != nil {
+				return err
+			}
+
+			message.PendingRemovals = append(message.PendingRemovals, removals...)
+		case 5:
+			return message.StakingPoolMappings.unmarshal(f.bytes)
+		case 6:
+			return message.InactiveValidators.unmarshal(f.bytes)
+		case 7:
+			return message.ValidatorCandidates.unmarshal(f.bytes)
+		case 8:
+			return message.unmarshalAtRiskEntry(f.bytes)
+		}
+
+		return nil
+	})
+}
+
+// unmarshalAtRiskEntry decodes a single entry of the at_risk_validators map.
+func (message *validatorSet) unmarshalAtRiskEntry(data []byte) error {
+	var (
+		address string
+		epochs  uint64
+	)
+
+	err := walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			address = string(f.bytes)
+		case 2:
+			epochs = f.varint
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if message.AtRiskValidators == nil {
+		message.AtRiskValidators = make(map[string]uint64)
+	}
+
+	message.AtRiskValidators[address] = epochs
+
+	return nil
+}
+
+func (message *moveTable) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.ID = string(f.bytes)
+		case 2:
+			message.Size = f.varint
+		}
+
+		return nil
+	})
+}
+
+//nolint:gocyclo // flat mapping of the validator fields
+func (message *validator) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.Name = string(f.bytes)
+		case 2:
+			message.Address = string(f.bytes)
+		case 3:
+			message.Description = string(f.bytes)
+		case 4:
+			message.ImageURL = string(f.bytes)
+		case 5:
+			message.ProjectURL = string(f.bytes)
+		case 7:
+			message.ProtocolPublicKey = f.bytes
+		case 8:
+			message.ProofOfPossession = f.bytes
+		case 10:
+			message.NetworkPublicKey = f.bytes
+		case 12:
+			message.WorkerPublicKey = f.bytes
+		case 13:
+			message.NetworkAddress = string(f.bytes)
+		case 14:
+			message.P2PAddress = string(f.bytes)
+		case 15:
+			message.PrimaryAddress = string(f.bytes)
+		case 16:
+			message.WorkerAddress = string(f.bytes)
+		case 18:
+			message.NextEpochProtocolPublicKey = f.bytes
+		case 19:
+			message.NextEpochProofOfPossession = f.bytes
+		case 20:
+			message.NextEpochNetworkPublicKey = f.bytes
+		case 21:
+			message.NextEpochWorkerPublicKey = f.bytes
+		case 22:
+			message.NextEpochNetworkAddress = stringPtr(f.bytes)
+		case 23:
+			message.NextEpochP2PAddress = stringPtr(f.bytes)
+		case 24:
+			message.NextEpochPrimaryAddress = stringPtr(f.bytes)
+		case 25:
+			message.NextEpochWorkerAddress = stringPtr(f.bytes)
+		case 27:
+			message.VotingPower = f.varint
+		case 28:
+			message.OperationCapID = string(f.bytes)
+		case 29:
+			message.GasPrice = f.varint
+		case 30:
+			return message.StakingPool.unmarshal(f.bytes)
+		case 31:
+			message.CommissionRate = f.varint
+		case 32:
+			message.NextEpochStake = f.varint
+		case 33:
+			message.NextEpochGasPrice = f.varint
+		case 34:
+			message.NextEpochCommissionRate = f.varint
+		}
+
+		return nil
+	})
+}
+
+func (message *stakingPool) unmarshal(data []byte) error {
+	return walkFields(data, func(f field) error {
+		switch f.number {
+		case 1:
+			message.ID = string(f.bytes)
+		case 2:
+			message.ActivationEpoch = f.varint
+		case 3:
+			deactivationEpoch := f.varint
+			message.DeactivationEpoch = &deactivationEpoch
+		case 4:
+			message.SuiBalance = f.varint
+		case 5:
+			message.RewardsPool = f.varint
+		case 6:
+			message.PoolTokenBalance = f.varint
+		case 7:
+			return message.ExchangeRates.unmarshal(f.bytes)
+		case 8:
+			message.PendingStake = f.varint
+		case 9:
+			message.PendingTotalSuiWithdraw = f.varint
+		case 10:
+			message.PendingPoolTokenWithdraw = f.varint
+		}
+
+		return nil
+	})
+}
+
+// stringPtr returns a pointer to the string value of the encoded bytes.
+func stringPtr(data []byte) *string {
+	value := string(data)
+
+	return &value
+}
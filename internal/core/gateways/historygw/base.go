@@ -0,0 +1,61 @@
+package historygw
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	dirPermissions  = 0o755
+	filePermissions = 0o600
+
+	// openTimeout is how long to wait for the lock of the history file held by another suimon process.
+	openTimeout = 2 * time.Second
+)
+
+// Gateway stores the metrics history in a single bbolt file, one bucket per host with the samples keyed by their time.
+// The file is opened for each operation only, so the history can be written by a running monitor and queried at the same time.
+type Gateway struct {
+	path       string
+	retention  time.Duration
+	maxSamples int
+}
+
+// NewGateway creates the history gateway storing the samples in the file at the given path.
+// The samples older than the retention are removed and at most maxSamples samples are kept per host.
+func NewGateway(path string, retention time.Duration, maxSamples int) ports.HistoryGateway {
+	return &Gateway{
+		path:       path,
+		retention:  retention,
+		maxSamples: maxSamples,
+	}
+}
+
+// open opens the history file, creating it and its directory if needed unless it is opened read-only.
+func (gateway *Gateway) open(readOnly bool) (*bolt.DB, error) {
+	if !readOnly {
+		if err := os.MkdirAll(filepath.Dir(gateway.path), dirPermissions); err != nil {
+			return nil, fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+
+	db, err := bolt.Open(gateway.path, filePermissions, &bolt.Options{Timeout: openTimeout, ReadOnly: readOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file %s: %w", gateway.path, err)
+	}
+
+	return db, nil
+}
+
+// exists reports whether the history file has been created yet.
+func (gateway *Gateway) exists() bool {
+	_, err := os.Stat(gateway.path)
+
+	return err == nil
+}
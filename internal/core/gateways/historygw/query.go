@@ -0,0 +1,88 @@
+package historygw
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Keys returns the keys of all the hosts with recorded samples. It returns no keys if nothing has been recorded yet.
+func (gateway *Gateway) Keys() ([]ports.HistoryKey, error) {
+	if !gateway.exists() {
+		return nil, nil
+	}
+
+	db, err := gateway.open(true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var keys []ports.HistoryKey
+
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			var key ports.HistoryKey
+			if err := json.Unmarshal(name, &key); err != nil {
+				return fmt.Errorf("failed to decode history key: %w", err)
+			}
+
+			keys = append(keys, key)
+
+			return nil
+		})
+	})
+
+	return keys, err
+}
+
+// Samples returns the samples recorded for the host since the given time, oldest first. All the samples are returned for the zero time.
+func (gateway *Gateway) Samples(key ports.HistoryKey, since time.Time) ([]ports.HistorySample, error) {
+	if !gateway.exists() {
+		return nil, nil
+	}
+
+	bucketName, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode history key: %w", err)
+	}
+
+	db, err := gateway.open(true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var samples []ports.HistorySample
+
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+
+		sampleKey, value := cursor.First()
+		if !since.IsZero() {
+			sampleKey, value = cursor.Seek(encodeTime(since))
+		}
+
+		for ; sampleKey != nil; sampleKey, value = cursor.Next() {
+			var sample ports.HistorySample
+			if err := json.Unmarshal(value, &sample); err != nil {
+				return fmt.Errorf("failed to decode history sample: %w", err)
+			}
+
+			samples = append(samples, sample)
+		}
+
+		return nil
+	})
+
+	return samples, err
+}
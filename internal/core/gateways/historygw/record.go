@@ -0,0 +1,91 @@
+package historygw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Record stores the samples in the buckets of their hosts and prunes the samples exceeding the retention limits.
+func (gateway *Gateway) Record(records []ports.HistoryRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	db, err := gateway.open(false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	cutoff := time.Now().Add(-gateway.retention)
+
+	return db.Update(func(tx *bolt.Tx) error {
+		for _, record := range records {
+			bucketName, err := json.Marshal(record.Key)
+			if err != nil {
+				return fmt.Errorf("failed to encode history key: %w", err)
+			}
+
+			bucket, err := tx.CreateBucketIfNotExists(bucketName)
+			if err != nil {
+				return fmt.Errorf("failed to create history bucket: %w", err)
+			}
+
+			value, err := json.Marshal(record.Sample)
+			if err != nil {
+				return fmt.Errorf("failed to encode history sample: %w", err)
+			}
+
+			if err := bucket.Put(encodeTime(record.Sample.Time), value); err != nil {
+				return fmt.Errorf("failed to store history sample: %w", err)
+			}
+
+			if err := gateway.prune(bucket, cutoff); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// prune removes the samples recorded before the cutoff and the oldest samples above maxSamples.
+// The keys are collected first, as deleting while iterating would move the cursor.
+func (gateway *Gateway) prune(bucket *bolt.Bucket, cutoff time.Time) error {
+	var keys [][]byte
+
+	cursor := bucket.Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		keys = append(keys, key)
+	}
+
+	excess := len(keys) - gateway.maxSamples
+	cutoffKey := encodeTime(cutoff)
+
+	for idx, key := range keys {
+		if idx >= excess && bytes.Compare(key, cutoffKey) >= 0 {
+			break
+		}
+
+		if err := bucket.Delete(key); err != nil {
+			return fmt.Errorf("failed to prune history sample: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// encodeTime encodes the time as big-endian nanoseconds, so the samples are sorted by time in the bucket.
+func encodeTime(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+
+	return key
+}
@@ -0,0 +1,45 @@
+package mmdbgw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Gateway looks up the geolocation data in local MaxMind GeoLite2/GeoIP2 or DB-IP databases in the mmdb format.
+// The records found in all the databases are merged, so a city database can be combined with an ASN one.
+// The fallback gateway, if set, is used for the addresses not found in the databases.
+type Gateway struct {
+	readers    []*maxminddb.Reader
+	fallback   ports.GeoGateway
+	cliGateway *cligw.Gateway
+}
+
+// NewGateway opens the mmdb databases at the given paths. The readers are safe for concurrent use,
+// so a single gateway can be shared by all the hosts. The fallback gateway is optional.
+func NewGateway(cliGW *cligw.Gateway, paths []string, fallback ports.GeoGateway) (ports.GeoGateway, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no mmdb databases provided")
+	}
+
+	readers := make([]*maxminddb.Reader, 0, len(paths))
+
+	for _, path := range paths {
+		reader, err := maxminddb.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open mmdb database %s: %w", path, err)
+		}
+
+		readers = append(readers, reader)
+	}
+
+	return &Gateway{
+		readers:    readers,
+		fallback:   fallback,
+		cliGateway: cliGW,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package mmdbgw
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	languageEnglish       = "en"
+	regionalIndicatorBase = 0x1F1E6
+)
+
+// record represents the fields of the GeoLite2/GeoIP2 and DB-IP City, Country, ASN and ISP databases used by suimon.
+type record struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		ISOCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+	ASOrganization string `maxminddb:"autonomous_system_organization"`
+	Organization   string `maxminddb:"organization"`
+	ISP            string `maxminddb:"isp"`
+	ASNumber       uint   `maxminddb:"autonomous_system_number"`
+}
+
+// CallFor looks up the geolocation data for the provided IP address in the databases.
+// If the address is not found in any of them, or the lookup fails, the fallback gateway is used if set.
+func (gateway *Gateway) CallFor(ctx context.Context, ip net.IP) (*ports.IPResult, error) {
+	if ip == nil {
+		return nil, fmt.Errorf("no IP provided")
+	}
+
+	result, found, err := gateway.lookup(ip)
+
+	switch {
+	case (err != nil || !found) && gateway.fallback != nil:
+		return gateway.fallback.CallFor(ctx, ip)
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, fmt.Errorf("no geolocation data found for %s", ip)
+	}
+
+	return result, nil
+}
+
+// lookup merges the records found for the IP address in all the databases into the result.
+// It reports whether the address was found in any of them.
+func (gateway *Gateway) lookup(ip net.IP) (result *ports.IPResult, found bool, err error) {
+	result = &ports.IPResult{IP: ip, Company: new(ports.Company)}
+
+	for _, reader := range gateway.readers {
+		var current record
+
+		_, ok, lookupErr := reader.LookupNetwork(ip, &current)
+		if lookupErr != nil {
+			return nil, false, fmt.Errorf("failed to look up %s in %s database: %w", ip, reader.Metadata.DatabaseType, lookupErr)
+		}
+
+		if !ok {
+			continue
+		}
+
+		found = true
+
+		mergeRecord(result, &current)
+	}
+
+	return result, found, nil
+}
+
+// mergeRecord sets the fields of the result that are still empty from the record.
+func mergeRecord(result *ports.IPResult, current *record) {
+	setIfEmpty(&result.City, current.City.Names[languageEnglish])
+	setIfEmpty(&result.Country, current.Country.ISOCode)
+	setIfEmpty(&result.CountryName, current.Country.Names[languageEnglish])
+	setIfEmpty(&result.CountryEmoji, countryEmoji(current.Country.ISOCode))
+
+	if len(current.Subdivisions) > 0 {
+		setIfEmpty(&result.Region, current.Subdivisions[0].Names[languageEnglish])
+	}
+
+	if current.Location.Latitude != nil && current.Location.Longitude != nil {
+		setIfEmpty(&result.Location, fmt.Sprintf("%.4f,%.4f", *current.Location.Latitude, *current.Location.Longitude))
+	}
+
+	if current.ASNumber != 0 {
+		setIfEmpty(&result.ASN, "AS"+strconv.FormatUint(uint64(current.ASNumber), 10))
+	}
+
+	for _, name := range []string{current.Organization, current.ISP, current.ASOrganization} {
+		setIfEmpty(&result.Company.Name, name)
+	}
+}
+
+// setIfEmpty sets the target to the value if the target is empty.
+func setIfEmpty(target *string, value string) {
+	if *target == "" {
+		*target = value
+	}
+}
+
+// countryEmoji returns the flag emoji of the country with the given ISO 3166-1 alpha-2 code.
+func countryEmoji(isoCode string) string {
+	if len(isoCode) != 2 {
+		return ""
+	}
+
+	var builder strings.Builder
+
+	for _, letter := range strings.ToUpper(isoCode) {
+		if letter < 'A' || letter > 'Z' {
+			return ""
+		}
+
+		builder.WriteRune(regionalIndicatorBase + letter - 'A')
+	}
+
+	return builder.String()
+}
@@ -1,20 +1,20 @@
 package prometheusgw
 
 import (
-	"context"
 	"net/http"
 	"time"
 
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/latency"
 )
 
 const httpClientTimeout = 3 * time.Second
 
 type Gateway struct {
-	ctx        context.Context
 	client     *http.Client
 	cliGateway *cligw.Gateway
+	latency    *latency.Tracker
 	url        string
 }
 
@@ -24,9 +24,14 @@ func NewGateway(cliGW *cligw.Gateway, url string) ports.PrometheusGateway {
 	}
 
 	return &Gateway{
-		ctx:        context.Background(),
 		url:        url,
 		client:     &httpClient,
 		cliGateway: cliGW,
+		latency:    latency.NewTracker(),
 	}
 }
+
+// Latency returns the round-trip time statistics for the calls made through the gateway.
+func (gateway *Gateway) Latency() latency.Stats {
+	return gateway.latency.Stats()
+}
@@ -3,7 +3,9 @@ package prometheusgw
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	ioPrometheusClient "github.com/prometheus/client_model/go"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/histogram"
 )
 
 type MetricsData map[string]*ioPrometheusClient.MetricFamily
@@ -29,8 +32,9 @@ var extractMetricValue = map[enums.PrometheusMetricType]func(metric *ioPrometheu
 }
 
 // CallFor makes an HTTP request to the specified gateway URL to fetch metrics.
-// It returns the metrics result or an error if something goes wrong.
-func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResult, err error) {
+// The request is canceled when the provided context is done or the client timeout expires.
+// It returns the metrics result or an error if the request fails; the metrics not found in the response carry their own errors.
+func (gateway *Gateway) CallFor(ctx context.Context, metrics ports.Metrics) (result ports.MetricsResult, err error) {
 	if len(metrics) == 0 {
 		return nil, fmt.Errorf("no metrics provided")
 	}
@@ -40,7 +44,7 @@ func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResu
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(gateway.ctx, httpClientTimeout)
+	ctx, cancel := context.WithTimeout(ctx, httpClientTimeout)
 	defer cancel()
 
 	req = req.WithContext(ctx)
@@ -48,9 +52,13 @@ func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResu
 	respChan := make(chan responseWithError, 1)
 
 	go func() {
+		start := time.Now()
+
 		//nolint:bodyclose // The response body is closed below to handle the response properly.
 		resp, reqErr := gateway.client.Do(req)
 
+		gateway.latency.Since(start)
+
 		respChan <- responseWithError{response: resp, err: reqErr}
 	}()
 
@@ -84,10 +92,11 @@ func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResu
 
 		metricsResult := make(ports.MetricsResult)
 
+		// A metric missing from the response does not fail the call, the error is set on its result instead.
 		for metricName, metricConfig := range metrics {
-			result, getMetricValueErr := getMetricValueWithLabelFiltering(data, metricName.ToString(), metricConfig)
+			result, getMetricValueErr := getMetricValueWithLabelFiltering(data, metricName, metricConfig)
 			if getMetricValueErr != nil {
-				return nil, getMetricValueErr
+				result = ports.MetricResult{Err: getMetricValueErr}
 			}
 
 			metricsResult[metricName] = result
@@ -98,9 +107,10 @@ func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResu
 }
 
 // getMetricValueWithLabelFiltering searches for a specific metric in the provided MetricsData
-// by the given metricName and metricConfig. If a matching metric is found, it returns
-// the metric result containing its value and labels. If no matching metric is found, it returns an error.
-func getMetricValueWithLabelFiltering(metrics MetricsData, metricName string, metricConfig ports.MetricConfig) (result ports.MetricResult, err error) {
+// by the names of the metricConfig tried in order, e.g. the names used by different sui-node releases.
+// If a matching metric is found, it returns the metric result containing its value and labels.
+// If no matching metric is found under any of the names, it returns an error wrapping ports.ErrMetricNotFound.
+func getMetricValueWithLabelFiltering(metrics MetricsData, metricName enums.PrometheusMetricName, metricConfig ports.MetricConfig) (result ports.MetricResult, err error) {
 	if metrics == nil {
 		return result, fmt.Errorf("no metrics provided")
 	}
@@ -111,21 +121,73 @@ func getMetricValueWithLabelFiltering(metrics MetricsData, metricName string, me
 		return result, fmt.Errorf("invalid metric type: %v", metricType)
 	}
 
-	metricFamily, found := metrics[metricName]
-	if !found {
-		return result, fmt.Errorf("no metric family found for metric: %s with type: %v", metricName, metricType)
-	}
+	names := metricConfig.Names(metricName)
+
+	for _, name := range names {
+		metricFamily, found := metrics[name.ToString()]
+		if !found {
+			continue
+		}
 
-	for _, metric := range metricFamily.Metric {
-		if matchLabels(labels, metric.Label) {
-			result.Labels = extractLabels(metric.Label)
-			result.Value = extractMetricValue[metricType](metric)
+		for _, metric := range metricFamily.Metric {
+			if matchLabels(labels, metric.Label) {
+				result.Name = name
+				result.Labels = extractLabels(metric.Label)
+				result.Value = extractMetricValue[metricType](metric)
+				result.Histogram = extractHistogram(metricType, metric)
 
-			return result, nil
+				return result, nil
+			}
 		}
 	}
 
-	return result, fmt.Errorf("no metric found matching labels: %v", labels)
+	return result, fmt.Errorf("%w: %v with type: %v matching labels: %v", ports.ErrMetricNotFound, names, metricType, labels)
+}
+
+// extractHistogram extracts the buckets of a histogram or the quantiles of a summary together with the sample sum and count.
+// It returns nil for the other metric types.
+func extractHistogram(metricType enums.PrometheusMetricType, metric *ioPrometheusClient.Metric) *histogram.Snapshot {
+	//nolint:exhaustive // only histograms and summaries carry distributions
+	switch metricType {
+	case enums.PrometheusMetricTypeHistogram:
+		metricHistogram := metric.GetHistogram()
+
+		snapshot := &histogram.Snapshot{
+			Buckets: make([]histogram.Bucket, 0, len(metricHistogram.GetBucket())+1),
+			Sum:     metricHistogram.GetSampleSum(),
+			Count:   float64(metricHistogram.GetSampleCount()),
+		}
+
+		for _, bucket := range metricHistogram.GetBucket() {
+			snapshot.Buckets = append(snapshot.Buckets, histogram.Bucket{
+				UpperBound:      bucket.GetUpperBound(),
+				CumulativeCount: float64(bucket.GetCumulativeCount()),
+			})
+		}
+
+		// The +Inf bucket is implicit in the text format and holds all the observations.
+		if len(snapshot.Buckets) == 0 || !math.IsInf(snapshot.Buckets[len(snapshot.Buckets)-1].UpperBound, 1) {
+			snapshot.Buckets = append(snapshot.Buckets, histogram.Bucket{UpperBound: math.Inf(1), CumulativeCount: snapshot.Count})
+		}
+
+		return snapshot
+	case enums.PrometheusMetricTypeSummary:
+		metricSummary := metric.GetSummary()
+
+		snapshot := &histogram.Snapshot{
+			Quantiles: make(map[float64]float64, len(metricSummary.GetQuantile())),
+			Sum:       metricSummary.GetSampleSum(),
+			Count:     float64(metricSummary.GetSampleCount()),
+		}
+
+		for _, quantile := range metricSummary.GetQuantile() {
+			snapshot.Quantiles[quantile.GetQuantile()] = quantile.GetValue()
+		}
+
+		return snapshot
+	default:
+		return nil
+	}
 }
 
 // matchLabels checks if all labels match.
@@ -0,0 +1,52 @@
+package promqlgw
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/latency"
+)
+
+const (
+	promqlClientTimeout = 3 * time.Second
+	promqlCallTimeout   = 5 * time.Second
+	queryPath           = "/api/v1/query"
+)
+
+// Gateway implements ports.PrometheusGateway on top of the Prometheus HTTP API.
+// Instead of scraping the host directly, the metrics of the host are queried from a Prometheus server
+// using the selector labels, e.g. the instance label the server scrapes the host with.
+type Gateway struct {
+	client     *http.Client
+	cliGateway *cligw.Gateway
+	latency    *latency.Tracker
+	selector   prometheus.Labels
+	url        string
+	rateRange  string
+}
+
+// NewGateway creates a gateway querying the Prometheus server at the given URL for the series matching the selector labels.
+// If the rate range is set, e.g. "1m", the per-second rates of the counters are computed by the server with rate() over the range.
+func NewGateway(cliGW *cligw.Gateway, url string, selector prometheus.Labels, rateRange string) ports.PrometheusGateway {
+	httpClient := &http.Client{
+		Timeout: promqlClientTimeout,
+	}
+
+	return &Gateway{
+		url:        url,
+		client:     httpClient,
+		cliGateway: cliGW,
+		latency:    latency.NewTracker(),
+		selector:   selector,
+		rateRange:  rateRange,
+	}
+}
+
+// Latency returns the round-trip time statistics for the queries made through the gateway.
+func (gateway *Gateway) Latency() latency.Stats {
+	return gateway.latency.Stats()
+}
@@ -0,0 +1,293 @@
+package promqlgw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/histogram"
+)
+
+const (
+	suffixBucket  = "_bucket"
+	suffixSum     = "_sum"
+	suffixCount   = "_count"
+	labelBound    = "le"
+	labelQuantile = "quantile"
+)
+
+// CallFor queries the Prometheus server for the latest values of the given metrics of the host.
+// All the series are fetched with a single instant query. If the rate range is configured,
+// the rates of the counters are then queried in parallel and set on the results.
+// The call is canceled when the provided context is done or the call timeout expires.
+func (gateway *Gateway) CallFor(ctx context.Context, metrics ports.Metrics) (ports.MetricsResult, error) {
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no metrics provided")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, promqlCallTimeout)
+	defer cancel()
+
+	names := make([]string, 0, len(metrics))
+	for metricName, metricConfig := range metrics {
+		for _, name := range metricConfig.Names(metricName) {
+			names = append(names, seriesNames(name.ToString(), metricConfig.MetricType)...)
+		}
+	}
+
+	sort.Strings(names)
+
+	samples, err := gateway.query(ctx, seriesSelector(names, gateway.selector))
+	if err != nil {
+		return nil, err
+	}
+
+	series := groupByName(samples)
+
+	metricsResult := make(ports.MetricsResult, len(metrics))
+
+	// A metric missing on the server does not fail the call, the error is set on its result instead.
+	for metricName, metricConfig := range metrics {
+		result, extractErr := extractMetricByNames(series, metricName, metricConfig)
+		if extractErr != nil {
+			result = ports.MetricResult{Err: extractErr}
+		}
+
+		metricsResult[metricName] = result
+	}
+
+	if gateway.rateRange == "" {
+		return metricsResult, nil
+	}
+
+	if err := gateway.setRates(ctx, metrics, metricsResult); err != nil {
+		return nil, err
+	}
+
+	return metricsResult, nil
+}
+
+// setRates queries the per-second rates of the counters over the rate range and sets them on the results.
+// Counters without enough samples on the server and the missing ones are left without the rate.
+func (gateway *Gateway) setRates(ctx context.Context, metrics ports.Metrics, metricsResult ports.MetricsResult) error {
+	var (
+		lock     sync.Mutex
+		errGroup errgroup.Group
+	)
+
+	for metricName, metricConfig := range metrics {
+		if metricConfig.MetricType != enums.PrometheusMetricTypeCounter || metricsResult[metricName].Err != nil {
+			continue
+		}
+
+		metricName, metricConfig, seriesName := metricName, metricConfig, metricsResult[metricName].Name
+
+		errGroup.Go(func() error {
+			expression := fmt.Sprintf("rate(%s[%s])",
+				seriesSelector([]string{seriesName.ToString()}, gateway.selector, metricConfig.Labels), gateway.rateRange)
+
+			samples, err := gateway.query(ctx, expression)
+			if err != nil {
+				return err
+			}
+
+			if len(samples) == 0 {
+				return nil
+			}
+
+			rate, err := samples[0].value()
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			result := metricsResult[metricName]
+			result.Rate = &rate
+			metricsResult[metricName] = result
+
+			return nil
+		})
+	}
+
+	return errGroup.Wait()
+}
+
+// seriesNames returns the names of the series a metric of the given type is exposed with.
+func seriesNames(metricName string, metricType enums.PrometheusMetricType) []string {
+	//nolint:exhaustive // the other metric types are exposed as a single series
+	switch metricType {
+	case enums.PrometheusMetricTypeHistogram:
+		return []string{metricName + suffixBucket, metricName + suffixSum, metricName + suffixCount}
+	case enums.PrometheusMetricTypeSummary:
+		return []string{metricName, metricName + suffixSum, metricName + suffixCount}
+	default:
+		return []string{metricName}
+	}
+}
+
+// groupByName groups the samples by the metric name. The samples of every metric are sorted by their labels.
+func groupByName(samples []sample) map[string][]sample {
+	series := make(map[string][]sample)
+
+	for _, current := range samples {
+		series[current.name()] = append(series[current.name()], current)
+	}
+
+	for _, group := range series {
+		sort.Slice(group, func(left, right int) bool {
+			return labelsKey(group[left].labels()) < labelsKey(group[right].labels())
+		})
+	}
+
+	return series
+}
+
+// extractMetricByNames extracts the metric by the names of the metricConfig tried in order, e.g. the names used by different sui-node releases.
+// It returns an error wrapping ports.ErrMetricNotFound if the metric is not found under any of the names.
+func extractMetricByNames(series map[string][]sample, metricName enums.PrometheusMetricName, metricConfig ports.MetricConfig) (result ports.MetricResult, err error) {
+	names := metricConfig.Names(metricName)
+
+	for _, name := range names {
+		result, err = extractMetric(series, name.ToString(), metricConfig)
+		if errors.Is(err, ports.ErrMetricNotFound) {
+			continue
+		}
+
+		result.Name = name
+
+		return result, err
+	}
+
+	return result, fmt.Errorf("%w: %v with type: %v matching labels: %v", ports.ErrMetricNotFound, names, metricConfig.MetricType, metricConfig.Labels)
+}
+
+// extractMetric finds the series of the metric matching the configured labels and converts them into the metric result.
+// It returns an error wrapping ports.ErrMetricNotFound if no matching series are found.
+func extractMetric(series map[string][]sample, metricName string, metricConfig ports.MetricConfig) (result ports.MetricResult, err error) {
+	metricType, labels := metricConfig.MetricType, metricConfig.Labels
+
+	switch metricType {
+	case enums.PrometheusMetricTypeHistogram, enums.PrometheusMetricTypeSummary:
+		return extractDistribution(series, metricName, metricConfig)
+	case enums.PrometheusMetricTypeGauge, enums.PrometheusMetricTypeCounter, enums.PrometheusMetricTypeUntyped:
+		for _, current := range series[metricName] {
+			if !current.matches(labels) {
+				continue
+			}
+
+			result.Labels = current.labels()
+			result.Value, err = current.value()
+
+			return result, err
+		}
+
+		return result, fmt.Errorf("%w: %s matching labels: %v", ports.ErrMetricNotFound, metricName, labels)
+	default:
+		return result, fmt.Errorf("invalid metric type: %v", metricType)
+	}
+}
+
+// extractDistribution builds the histogram snapshot of a histogram or a summary from its series.
+// The value of the result is the sample sum, the same as for the scraped metrics.
+func extractDistribution(series map[string][]sample, metricName string, metricConfig ports.MetricConfig) (result ports.MetricResult, err error) {
+	var countSample *sample
+
+	for idx := range series[metricName+suffixCount] {
+		if current := series[metricName+suffixCount][idx]; current.matches(metricConfig.Labels) {
+			countSample = &current
+			break
+		}
+	}
+
+	if countSample == nil {
+		return result, fmt.Errorf("%w: %s matching labels: %v", ports.ErrMetricNotFound, metricName, metricConfig.Labels)
+	}
+
+	result.Labels = countSample.labels()
+	key := labelsKey(result.Labels)
+
+	snapshot := &histogram.Snapshot{}
+
+	if snapshot.Count, err = countSample.value(); err != nil {
+		return result, err
+	}
+
+	for _, current := range series[metricName+suffixSum] {
+		if labelsKey(current.labels()) == key {
+			if snapshot.Sum, err = current.value(); err != nil {
+				return result, err
+			}
+
+			break
+		}
+	}
+
+	if metricConfig.MetricType == enums.PrometheusMetricTypeHistogram {
+		err = collectBuckets(snapshot, series[metricName+suffixBucket], key)
+	} else {
+		err = collectQuantiles(snapshot, series[metricName], key)
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Value = snapshot.Sum
+	result.Histogram = snapshot
+
+	return result, nil
+}
+
+// collectBuckets sets the buckets of the series with the given labels on the snapshot.
+func collectBuckets(snapshot *histogram.Snapshot, samples []sample, key string) error {
+	for _, current := range samples {
+		if labelsKey(current.labels(labelBound)) != key {
+			continue
+		}
+
+		upperBound, err := strconv.ParseFloat(current.Metric[labelBound], 64)
+		if err != nil {
+			return fmt.Errorf("invalid bucket bound %s: %w", current.Metric[labelBound], err)
+		}
+
+		count, err := current.value()
+		if err != nil {
+			return err
+		}
+
+		snapshot.Buckets = append(snapshot.Buckets, histogram.Bucket{UpperBound: upperBound, CumulativeCount: count})
+	}
+
+	return nil
+}
+
+// collectQuantiles sets the quantiles of the series with the given labels on the snapshot.
+func collectQuantiles(snapshot *histogram.Snapshot, samples []sample, key string) error {
+	snapshot.Quantiles = make(map[float64]float64)
+
+	for _, current := range samples {
+		if labelsKey(current.labels(labelQuantile)) != key {
+			continue
+		}
+
+		quantile, err := strconv.ParseFloat(current.Metric[labelQuantile], 64)
+		if err != nil {
+			return fmt.Errorf("invalid quantile %s: %w", current.Metric[labelQuantile], err)
+		}
+
+		if snapshot.Quantiles[quantile], err = current.value(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package promqlgw
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	labelName        = "__name__"
+	resultTypeVector = "vector"
+	statusSuccess    = "success"
+)
+
+type (
+	// response represents the envelope of the Prometheus HTTP API responses.
+	response struct {
+		Data      responseData `json:"data"`
+		Status    string       `json:"status"`
+		ErrorType string       `json:"errorType"`
+		Error     string       `json:"error"`
+	}
+
+	responseData struct {
+		ResultType string   `json:"resultType"`
+		Result     []sample `json:"result"`
+	}
+
+	// sample represents a single series of an instant vector.
+	// The value is a [timestamp, "value"] pair.
+	sample struct {
+		Metric map[string]string `json:"metric"`
+		Value  []json.RawMessage `json:"value"`
+	}
+)
+
+// query evaluates the PromQL expression at the current time and returns the resulting instant vector.
+func (gateway *Gateway) query(ctx context.Context, expression string) ([]sample, error) {
+	endpoint, err := url.JoinPath(gateway.url, queryPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prometheus url %s: %w", gateway.url, err)
+	}
+
+	body := url.Values{"query": []string{expression}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promql request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	start := time.Now()
+
+	resp, err := gateway.client.Do(req)
+
+	gateway.latency.Since(start)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get response from prometheus server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var payload response
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("failed to decode promql response with status %s: %w", resp.Status, err)
+	}
+
+	if payload.Status != statusSuccess {
+		return nil, fmt.Errorf("promql query %s failed: %s: %s", expression, payload.ErrorType, payload.Error)
+	}
+
+	if payload.Data.ResultType != resultTypeVector {
+		return nil, fmt.Errorf("unexpected promql result type: %s", payload.Data.ResultType)
+	}
+
+	return payload.Data.Result, nil
+}
+
+// value parses the value of the sample.
+func (s sample) value() (float64, error) {
+	if len(s.Value) != 2 {
+		return 0, errors.New("invalid promql sample value")
+	}
+
+	var raw string
+	if err := json.Unmarshal(s.Value[1], &raw); err != nil {
+		return 0, fmt.Errorf("invalid promql sample value: %w", err)
+	}
+
+	return strconv.ParseFloat(raw, 64)
+}
+
+// name returns the name of the metric the sample belongs to.
+func (s sample) name() string {
+	return s.Metric[labelName]
+}
+
+// labels returns the labels of the sample without the metric name and the given labels.
+func (s sample) labels(exclude ...string) prometheus.Labels {
+	labels := make(prometheus.Labels, len(s.Metric))
+
+	for key, value := range s.Metric {
+		labels[key] = value
+	}
+
+	delete(labels, labelName)
+
+	for _, key := range exclude {
+		delete(labels, key)
+	}
+
+	return labels
+}
+
+// matches reports whether the sample has all the given labels.
+func (s sample) matches(labels prometheus.Labels) bool {
+	for key, value := range labels {
+		if s.Metric[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// seriesSelector builds the series selector matching the given metric names and labels.
+func seriesSelector(names []string, labels ...prometheus.Labels) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+
+	matchers := []string{fmt.Sprintf("%s=~%s", labelName, strconv.Quote(strings.Join(quoted, "|")))}
+
+	for _, labelSet := range labels {
+		for key, value := range labelSet {
+			matchers = append(matchers, fmt.Sprintf("%s=%s", key, strconv.Quote(value)))
+		}
+	}
+
+	sort.Strings(matchers[1:])
+
+	return "{" + strings.Join(matchers, ",") + "}"
+}
+
+// labelsKey returns a key identifying the label set.
+func labelsKey(labels prometheus.Labels) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString("=")
+		builder.WriteString(strconv.Quote(labels[key]))
+		builder.WriteString(",")
+	}
+
+	return builder.String()
+}
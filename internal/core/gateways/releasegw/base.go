@@ -0,0 +1,46 @@
+package releasegw
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/diskcache"
+)
+
+const (
+	httpClientTimeout = 10 * time.Second
+
+	// perPage is the maximum number of releases returned on a page by the GitHub API.
+	perPage = 100
+
+	// maxPages bounds the number of pages fetched if the releases matching are scarce.
+	maxPages = 10
+)
+
+// Gateway fetches the releases of a repository from the GitHub releases API or a compatible mirror.
+// The responses are cached on disk with their ETag and revalidated with If-None-Match, so the unchanged
+// responses do not count against the rate limit. The cache is optional.
+type Gateway struct {
+	client     *http.Client
+	cache      *diskcache.Cache
+	cliGateway *cligw.Gateway
+	apiURL     string
+	repository string
+	token      string
+}
+
+// NewGateway creates the release gateway for the repository, e.g. MystenLabs/sui, served by the API at the base URL.
+// The token is sent as a bearer token if set, raising the rate limit of the anonymous requests.
+func NewGateway(cliGW *cligw.Gateway, apiURL, repository, token string, cache *diskcache.Cache) ports.ReleaseGateway {
+	return &Gateway{
+		client:     &http.Client{Timeout: httpClientTimeout},
+		cache:      cache,
+		cliGateway: cliGW,
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		repository: strings.Trim(repository, "/"),
+		token:      token,
+	}
+}
@@ -0,0 +1,183 @@
+package releasegw
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// cachedResponse represents a response stored in the cache together with its ETag.
+type cachedResponse struct {
+	ETag string          `json:"etag"`
+	Body json.RawMessage `json:"body"`
+}
+
+// Releases fetches the releases page by page, newest first, until the number of releases matching the query is found,
+// all the releases are fetched or the maximum number of pages is reached.
+func (gateway *Gateway) Releases(ctx context.Context, query ports.ReleaseQuery) ([]metrics.Release, error) {
+	var matching []metrics.Release
+
+	for page := 1; page <= maxPages; page++ {
+		releases, err := gateway.getPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, release := range releases {
+			if query.Pattern != nil && !query.Pattern.MatchString(release.Name) && !query.Pattern.MatchString(release.TagName) {
+				continue
+			}
+
+			matching = append(matching, release)
+
+			if query.Count > 0 && len(matching) == query.Count {
+				return matching, nil
+			}
+		}
+
+		if len(releases) < perPage {
+			break
+		}
+	}
+
+	return matching, nil
+}
+
+// Release fetches the release with the tag name.
+func (gateway *Gateway) Release(ctx context.Context, tag string) (*metrics.Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases/tags/%s", gateway.apiURL, gateway.repository, neturl.PathEscape(tag))
+
+	var release metrics.Release
+	if err := gateway.get(ctx, url, &release); err != nil {
+		return nil, fmt.Errorf("failed to get release %s: %w", tag, err)
+	}
+
+	return &release, nil
+}
+
+// getPage fetches a page of releases.
+func (gateway *Gateway) getPage(ctx context.Context, page int) ([]metrics.Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases?per_page=%d&page=%d", gateway.apiURL, gateway.repository, perPage, page)
+
+	var releases []metrics.Release
+	if err := gateway.get(ctx, url, &releases); err != nil {
+		return nil, err
+	}
+
+	return releases, nil
+}
+
+// get fetches the URL and decodes the response into the value provided, revalidating the cached response if there is any.
+// The cached response is used if the API responds it is not modified, or if the API cannot be reached
+// or rejects the request, e.g. when the rate limit is exceeded, in which case a warning is shown.
+func (gateway *Gateway) get(ctx context.Context, url string, value any) error {
+	cached, found := gateway.getCached(url)
+
+	body, etag, err := gateway.fetch(ctx, url, cached.ETag)
+
+	switch {
+	case err != nil && found && ctx.Err() == nil:
+		gateway.cliGateway.Warn(fmt.Sprintf("using cached releases: %v", err))
+
+		body = cached.Body
+	case err != nil:
+		return err
+	case body == nil:
+		body = cached.Body
+	default:
+		gateway.setCached(url, cachedResponse{ETag: etag, Body: body})
+	}
+
+	if err := json.Unmarshal(body, value); err != nil {
+		return fmt.Errorf("failed to decode releases: %w", err)
+	}
+
+	return nil
+}
+
+// fetch requests the URL, sending the ETag of the cached response if there is any.
+// It returns a nil body if the response is not modified since it was cached.
+func (gateway *Gateway) fetch(ctx context.Context, url, etag string) (body []byte, newETag string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("User-Agent", "suimon")
+
+	if gateway.token != "" {
+		req.Header.Set("Authorization", "Bearer "+gateway.token)
+	}
+
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := gateway.client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			slog.Error("failed to close response body", "error", closeErr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, "", fmt.Errorf("not found: %s", url)
+	case http.StatusNotModified:
+		if etag != "" {
+			return nil, etag, nil
+		}
+
+		fallthrough
+	default:
+		return nil, "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, resp.Header.Get("ETag"), nil
+}
+
+// getCached returns the response cached for the URL, if the cache is enabled and holds one.
+func (gateway *Gateway) getCached(url string) (cachedResponse, bool) {
+	var cached cachedResponse
+
+	if gateway.cache == nil {
+		return cached, false
+	}
+
+	found, err := gateway.cache.Get(url, &cached)
+	if err != nil {
+		gateway.cliGateway.Warn(fmt.Sprintf("failed to read releases cache: %v", err))
+	}
+
+	return cached, found && err == nil
+}
+
+// setCached stores the response fetched for the URL, if the cache is enabled.
+func (gateway *Gateway) setCached(url string, response cachedResponse) {
+	if gateway.cache == nil {
+		return
+	}
+
+	if err := gateway.cache.Set(url, response); err != nil {
+		gateway.cliGateway.Warn(fmt.Sprintf("failed to write releases cache: %v", err))
+	}
+}
@@ -0,0 +1,66 @@
+package reportgw
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+// Gateway saves the reports as Markdown and JSON files, one directory per network.
+type Gateway struct {
+	dir string
+}
+
+// NewGateway creates the report gateway saving the reports under the given directory.
+func NewGateway(dir string) ports.ReportGateway {
+	return &Gateway{
+		dir: dir,
+	}
+}
+
+// SaveEpochReport saves the epoch report to <network>/epoch-N.md and <network>/epoch-N.json, overwriting
+// the earlier report of the same epoch, and returns the paths of the files written.
+func (gateway *Gateway) SaveEpochReport(report *metrics.EpochReport) ([]string, error) {
+	networkDir := filepath.Join(gateway.dir, strings.ToLower(report.Network))
+
+	if err := os.MkdirAll(networkDir, dirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create reports directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode epoch %s report: %w", report.Epoch, err)
+	}
+
+	basePath := filepath.Join(networkDir, "epoch-"+report.Epoch)
+
+	files := []struct {
+		path string
+		data []byte
+	}{
+		{path: basePath + ".md", data: []byte(report.Markdown())},
+		{path: basePath + ".json", data: data},
+	}
+
+	paths := make([]string, 0, len(files))
+
+	for _, file := range files {
+		if err := os.WriteFile(file.path, file.data, filePermissions); err != nil {
+			return nil, fmt.Errorf("failed to write epoch %s report: %w", report.Epoch, err)
+		}
+
+		paths = append(paths, file.path)
+	}
+
+	return paths, nil
+}
@@ -1,7 +1,6 @@
 package rpcgw
 
 import (
-	"context"
 	"net/http"
 	"time"
 
@@ -9,14 +8,15 @@ import (
 
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/latency"
 )
 
 const rpcClientTimeout = 3 * time.Second
 
 type Gateway struct {
-	ctx        context.Context
 	client     jsonrpc.RPCClient
 	cliGateway *cligw.Gateway
+	latency    *latency.Tracker
 	url        string
 }
 
@@ -32,9 +32,14 @@ func NewGateway(cliGW *cligw.Gateway, url string) ports.RPCGateway {
 	rpcClient := jsonrpc.NewClientWithOpts(url, opts)
 
 	return &Gateway{
-		ctx:        context.Background(),
 		url:        url,
 		client:     rpcClient,
 		cliGateway: cliGW,
+		latency:    latency.NewTracker(),
 	}
 }
+
+// Latency returns the round-trip time statistics for the calls made through the gateway.
+func (gateway *Gateway) Latency() latency.Stats {
+	return gateway.latency.Stats()
+}
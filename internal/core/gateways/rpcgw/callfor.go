@@ -3,6 +3,7 @@ package rpcgw
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 )
@@ -13,20 +14,25 @@ type responseWithError struct {
 }
 
 // CallFor makes an RPC call for the specified method with the given parameters.
+// The call is canceled when the provided context is done or the client timeout expires.
 // It returns the result of the RPC call and an error if any.
-func (gateway *Gateway) CallFor(method enums.RPCMethod, params ...interface{}) (result any, err error) {
-	respChan := make(chan responseWithError)
+func (gateway *Gateway) CallFor(ctx context.Context, method enums.RPCMethod, params ...interface{}) (result any, err error) {
+	respChan := make(chan responseWithError, 1)
 
-	ctx, cancel := context.WithTimeout(gateway.ctx, rpcClientTimeout)
+	ctx, cancel := context.WithTimeout(ctx, rpcClientTimeout)
 	defer cancel()
 
 	go func() {
 		var resp any
 
+		start := time.Now()
+
 		callErr := gateway.client.CallFor(ctx, &resp, method.String(), params)
 
+		gateway.latency.Since(start)
+
 		if callErr != nil || resp == nil {
-			respChan <- responseWithError{response: nil, err: fmt.Errorf("failed to get response from RPC client: %w", err)}
+			respChan <- responseWithError{response: nil, err: fmt.Errorf("failed to get response from RPC client: %w", callErr)}
 		} else {
 			respChan <- responseWithError{response: resp, err: nil}
 		}
@@ -0,0 +1,110 @@
+package snapshotgw
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	dirPermissions  = 0o755
+	filePermissions = 0o600
+)
+
+// Gateway stores the validator set snapshots in a JSON file per network, one snapshot per epoch.
+type Gateway struct {
+	dir          string
+	maxSnapshots int
+}
+
+// NewGateway creates the snapshot gateway storing the snapshots under the given directory.
+// At most maxSnapshots snapshots of the latest epochs are kept per network.
+func NewGateway(dir string, maxSnapshots int) ports.SnapshotGateway {
+	return &Gateway{
+		dir:          dir,
+		maxSnapshots: maxSnapshots,
+	}
+}
+
+// ValidatorSetSnapshots returns the validator set snapshots stored for the network, oldest epoch first.
+func (gateway *Gateway) ValidatorSetSnapshots(network string) ([]metrics.ValidatorSetSnapshot, error) {
+	data, err := os.ReadFile(gateway.path(network))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validator set snapshots: %w", err)
+	}
+
+	var snapshots []metrics.ValidatorSetSnapshot
+	if err := json.Unmarshal(data, &snapshots); err != nil {
+		return nil, fmt.Errorf("failed to decode validator set snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// SaveValidatorSetSnapshot stores the snapshot, replacing the one of the same epoch, and removes the oldest snapshots over the limit.
+func (gateway *Gateway) SaveValidatorSetSnapshot(snapshot metrics.ValidatorSetSnapshot) error {
+	snapshots, err := gateway.ValidatorSetSnapshots(snapshot.Network)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]metrics.ValidatorSetSnapshot, 0, len(snapshots)+1)
+
+	for _, stored := range snapshots {
+		if stored.Epoch != snapshot.Epoch {
+			kept = append(kept, stored)
+		}
+	}
+
+	kept = append(kept, snapshot)
+
+	sort.SliceStable(kept, func(left, right int) bool {
+		return epochNumber(kept[left].Epoch) < epochNumber(kept[right].Epoch)
+	})
+
+	if gateway.maxSnapshots > 0 && len(kept) > gateway.maxSnapshots {
+		kept = kept[len(kept)-gateway.maxSnapshots:]
+	}
+
+	data, err := json.Marshal(kept)
+	if err != nil {
+		return fmt.Errorf("failed to encode validator set snapshots: %w", err)
+	}
+
+	if err := os.MkdirAll(gateway.dir, dirPermissions); err != nil {
+		return fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	if err := os.WriteFile(gateway.path(snapshot.Network), data, filePermissions); err != nil {
+		return fmt.Errorf("failed to write validator set snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// path returns the path of the snapshots file of the network.
+func (gateway *Gateway) path(network string) string {
+	return filepath.Join(gateway.dir, strings.ToLower(network)+".json")
+}
+
+// epochNumber parses the epoch, ordering the unparsable ones first.
+func epochNumber(epoch string) int64 {
+	number, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return number
+}
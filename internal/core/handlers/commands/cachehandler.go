@@ -0,0 +1,64 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type CacheHandler struct {
+	command    *cobra.Command
+	controller ports.CacheController
+}
+
+func NewCacheHandler(
+	controller ports.CacheController,
+) *CacheHandler {
+	handler := &CacheHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *CacheHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *CacheHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *CacheHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *CacheHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the data cached by the suimon monitoring tool",
+		Long:  "The suimon cache subcommand manages the data suimon caches on disk under ~/.suimon/cache, such as the geolocation of the monitored hosts and validators. The cached data expires after the TTL set in the config file.",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "clear",
+		Short: "Remove all the data cached by the suimon monitoring tool",
+		Long:  "The suimon cache clear subcommand removes all the data cached under ~/.suimon/cache. The data is requested again on the next run.",
+		Run:   h.handleClearCommand,
+	})
+
+	return cmd
+}
+
+func (h *CacheHandler) handleClearCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.Clear(); err != nil {
+		slog.Error("Failed to clear cache", "error", err)
+	}
+}
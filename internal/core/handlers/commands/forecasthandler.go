@@ -0,0 +1,95 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	forecastPeriodsDefault = 12
+	forecastStakeDefault   = 1000
+	forecastWidthDefault   = 80
+)
+
+type ForecastHandler struct {
+	command    *cobra.Command
+	controller ports.ForecastController
+	periods    int
+	stake      float64
+	width      int
+}
+
+func NewForecastHandler(
+	controller ports.ForecastController,
+) *ForecastHandler {
+	handler := &ForecastHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ForecastHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *ForecastHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ForecastHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ForecastHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forecast",
+		Short: "Forecast the stake subsidy distributions of the SUI network",
+		Long:  "The suimon forecast subcommand forecasts the stake subsidy distributions from the system state of the network selected: when the distribution per epoch decreases next, the distribution for the next subsidy periods, when the subsidy fund runs out and the staking rewards per epoch estimated for a stake. The rewards are the share of the stake in the subsidy distributed, before the validator commission and without the gas fees.",
+		Run:   h.handleCommand,
+	}
+
+	cmd.Flags().IntVar(&h.periods, "periods", forecastPeriodsDefault, "number of the stake subsidy periods to forecast")
+	cmd.Flags().Float64Var(&h.stake, "stake", forecastStakeDefault, "stake in SUI to estimate the staking rewards for")
+	cmd.Flags().IntVar(&h.width, "width", forecastWidthDefault, "width of the chart in characters")
+
+	return cmd
+}
+
+func (h *ForecastHandler) handleCommand(cmd *cobra.Command, _ []string) {
+	if h.periods < 1 {
+		slog.Error("Invalid --periods value", "value", h.periods)
+
+		return
+	}
+
+	if h.stake < 0 {
+		slog.Error("Invalid --stake value", "value", h.stake)
+
+		return
+	}
+
+	if h.width < 1 {
+		slog.Error("Invalid --width value", "value", h.width)
+
+		return
+	}
+
+	query := ports.SubsidyForecastQuery{
+		Periods: h.periods,
+		Stake:   h.stake,
+		Width:   h.width,
+	}
+
+	if err := h.controller.ForecastSubsidy(cmd.Context(), query); err != nil {
+		slog.Error("Failed to forecast subsidy", "error", err)
+	}
+}
@@ -0,0 +1,100 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	historyMetricDefault = "check_sync_percentage"
+	historySinceDefault  = "24h"
+	historyWidthDefault  = 80
+)
+
+type HistoryHandler struct {
+	command    *cobra.Command
+	controller ports.HistoryController
+	network    string
+	host       string
+	metric     string
+	since      string
+	width      int
+}
+
+func NewHistoryHandler(
+	controller ports.HistoryController,
+) *HistoryHandler {
+	handler := &HistoryHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *HistoryHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *HistoryHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *HistoryHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *HistoryHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Chart the metrics recorded by the suimon monitoring tool across runs",
+		Long:  "The suimon history subcommand charts a metric of the monitored hosts recorded in ~/.suimon/history.db on every run of the monitor, and lists the changes of the host status. Use it to find out when a host started falling behind. The samples are kept for the retention set in the history section of the config file.",
+		Run:   h.handleCommand,
+	}
+
+	cmd.Flags().StringVar(&h.network, "network", "", "network config name to show the history for, e.g. mainnet; all networks if empty")
+	cmd.Flags().StringVar(&h.host, "host", "", "show the hosts with the address containing the value only")
+	cmd.Flags().StringVar(&h.metric, "metric", historyMetricDefault, "metric to chart, e.g. check_sync_percentage, checkpoints_per_second or latency")
+	cmd.Flags().StringVar(&h.since, "since", historySinceDefault, "how far back to show the history, e.g. 6h or 7d")
+	cmd.Flags().IntVar(&h.width, "width", historyWidthDefault, "width of the chart in characters")
+
+	return cmd
+}
+
+func (h *HistoryHandler) handleCommand(_ *cobra.Command, _ []string) {
+	since, err := model.ParseDuration(h.since)
+	if err != nil {
+		slog.Error("Invalid --since value", "value", h.since, "error", err)
+
+		return
+	}
+
+	if h.width < 1 {
+		slog.Error("Invalid --width value", "value", h.width)
+
+		return
+	}
+
+	query := ports.HistoryQuery{
+		Network: h.network,
+		Host:    h.host,
+		Metric:  enums.MetricType(strings.ToUpper(h.metric)),
+		Since:   time.Duration(since),
+		Width:   h.width,
+	}
+
+	if err := h.controller.Show(query); err != nil {
+		slog.Error("Failed to show history", "error", err)
+	}
+}
@@ -1,7 +1,10 @@
 package cmdhandlers
 
 import (
+	"context"
+	"errors"
 	"log/slog"
+	"regexp"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +14,7 @@ import (
 type MonitorHandler struct {
 	command    *cobra.Command
 	controller ports.MonitorController
+	grep       string
 }
 
 func NewMonitorHandler(
@@ -25,8 +29,8 @@ func NewMonitorHandler(
 	return handler
 }
 
-func (h *MonitorHandler) Start() {
-	_ = h.command.Execute()
+func (h *MonitorHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
 }
 
 func (h *MonitorHandler) AddSubCommands(subcommands ...ports.Command) {
@@ -48,11 +52,32 @@ func (h *MonitorHandler) newCommand() *cobra.Command {
 		Run:     h.handleCommand,
 	}
 
+	cmd.Flags().StringVar(&h.grep, "grep", "", "show the protocol feature flags and attributes with the name matching the regular expression only, e.g. gas")
+
 	return cmd
 }
 
-func (h *MonitorHandler) handleCommand(_ *cobra.Command, _ []string) {
-	if err := h.controller.Monitor(); err != nil {
+func (h *MonitorHandler) handleCommand(cmd *cobra.Command, _ []string) {
+	var options ports.MonitorOptions
+
+	if h.grep != "" {
+		filter, err := regexp.Compile("(?i)" + h.grep)
+		if err != nil {
+			slog.Error("Invalid --grep value", "value", h.grep, "error", err)
+
+			return
+		}
+
+		options.ProtocolFilter = filter
+	}
+
+	if err := h.controller.Monitor(cmd.Context(), options); err != nil {
+		if errors.Is(err, context.Canceled) {
+			slog.Info("Monitoring stopped")
+
+			return
+		}
+
 		slog.Error("Failed to run", "error", err)
 	}
 }
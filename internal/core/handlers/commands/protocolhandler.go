@@ -0,0 +1,96 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ProtocolHandler struct {
+	command    *cobra.Command
+	controller ports.ProtocolController
+	from       uint64
+	to         uint64
+	grep       string
+}
+
+func NewProtocolHandler(
+	controller ports.ProtocolController,
+) *ProtocolHandler {
+	handler := &ProtocolHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ProtocolHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *ProtocolHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ProtocolHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ProtocolHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol",
+		Short: "Inspect the protocol config of the SUI network",
+		Long:  "The suimon protocol subcommand inspects the protocol config of the network selected, as reported by the reference RPC host.",
+	}
+
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "List the protocol config changes between two protocol versions",
+		Long:  "The suimon protocol diff subcommand fetches the protocol configs of two protocol versions and lists the feature flags and attributes added, removed or changed between them. By default, the current protocol version is compared with the maximum one supported by the network, previewing the changes of the upcoming protocol upgrade.",
+		Run:   h.handleDiffCommand,
+	}
+
+	diffCmd.Flags().Uint64Var(&h.from, "from", 0, "protocol version to compare from; the current protocol version if not set")
+	diffCmd.Flags().Uint64Var(&h.to, "to", 0, "protocol version to compare to; the maximum supported protocol version if not set")
+	diffCmd.Flags().StringVar(&h.grep, "grep", "", "show the feature flags and attributes with the name matching the regular expression only, e.g. gas")
+
+	cmd.AddCommand(diffCmd)
+
+	return cmd
+}
+
+func (h *ProtocolHandler) handleDiffCommand(cmd *cobra.Command, _ []string) {
+	var query ports.ProtocolDiffQuery
+
+	if h.from > 0 {
+		query.From = strconv.FormatUint(h.from, 10)
+	}
+
+	if h.to > 0 {
+		query.To = strconv.FormatUint(h.to, 10)
+	}
+
+	if h.grep != "" {
+		filter, err := regexp.Compile("(?i)" + h.grep)
+		if err != nil {
+			slog.Error("Invalid --grep value", "value", h.grep, "error", err)
+
+			return
+		}
+
+		query.Filter = filter
+	}
+
+	if err := h.controller.DiffProtocol(cmd.Context(), query); err != nil {
+		slog.Error("Failed to diff protocol versions", "error", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ReleasesHandler struct {
+	command    *cobra.Command
+	controller ports.ReleasesController
+}
+
+func NewReleasesHandler(
+	controller ports.ReleasesController,
+) *ReleasesHandler {
+	handler := &ReleasesHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ReleasesHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *ReleasesHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ReleasesHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ReleasesHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "releases",
+		Short: "Inspect the releases of the SUI network",
+		Long:  "The suimon releases subcommand inspects the releases of the network selected, fetched from the repository set in the releases section of the config file.",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "show <tag>",
+		Short: "Show the notes of a release",
+		Long:  "The suimon releases show subcommand prints the notes of the release with the tag, e.g. mainnet-v1.24.1, with the protocol version bump and the notes marking the upgrade as mandatory or breaking highlighted.",
+		Args:  cobra.ExactArgs(1),
+		Run:   h.handleShowCommand,
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "changes",
+		Short: "List the releases published since the versions run by the monitored hosts",
+		Long:  "The suimon releases changes subcommand lists, for every version run by the full nodes and validators of the config selected, the releases published since that version with their protocol version bumps and upgrade notes.",
+		Run:   h.handleChangesCommand,
+	})
+
+	return cmd
+}
+
+func (h *ReleasesHandler) handleShowCommand(cmd *cobra.Command, args []string) {
+	if err := h.controller.ShowRelease(cmd.Context(), args[0]); err != nil {
+		slog.Error("Failed to show release", "error", err)
+	}
+}
+
+func (h *ReleasesHandler) handleChangesCommand(cmd *cobra.Command, _ []string) {
+	if err := h.controller.ShowReleaseChanges(cmd.Context()); err != nil {
+		slog.Error("Failed to show release changes", "error", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cmdhandlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const reportsIntervalDefault = 30 * time.Second
+
+type ReportsHandler struct {
+	command    *cobra.Command
+	controller ports.ReportsController
+	interval   time.Duration
+}
+
+func NewReportsHandler(
+	controller ports.ReportsController,
+) *ReportsHandler {
+	handler := &ReportsHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ReportsHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
+}
+
+func (h *ReportsHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ReportsHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ReportsHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reports",
+		Short: "Produce the epoch summary reports of the SUI network",
+		Long:  "The suimon reports subcommand produces a summary report on every epoch change of the network selected, saved as Markdown and JSON to ~/.suimon/reports/<network>/epoch-N. The reports are also produced while the dynamic monitor is running.",
+	}
+
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch the epoch changes and save a report on each of them",
+		Long:  "The suimon reports watch subcommand polls the system state of the reference RPC host until interrupted and, when the epoch changes, saves the report of the epoch ended: the validators joined, left and pending removal, the stake changes per validator, the reference gas price change, the stake subsidy distributed, the storage fund change and the validators at risk or reported. Run it as a service to keep a report of every epoch.",
+		Run:   h.handleWatchCommand,
+	}
+
+	watchCmd.Flags().DurationVar(&h.interval, "interval", reportsIntervalDefault, "how often to poll the system state, e.g. 30s or 5m")
+
+	cmd.AddCommand(watchCmd)
+
+	return cmd
+}
+
+func (h *ReportsHandler) handleWatchCommand(cmd *cobra.Command, _ []string) {
+	if h.interval <= 0 {
+		slog.Error("Invalid --interval value", "value", h.interval)
+
+		return
+	}
+
+	if err := h.controller.WatchEpochs(cmd.Context(), h.interval); err != nil {
+		slog.Error("Failed to watch epochs", "error", err)
+	}
+}
@@ -1,6 +1,8 @@
 package cmdhandlers
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 
 	"github.com/bartosian/suimon/internal/core/ports"
@@ -23,8 +25,8 @@ func NewRootHandler(
 	return handler
 }
 
-func (h *RootHandler) Start() {
-	_ = h.command.Execute()
+func (h *RootHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
 }
 
 func (h *RootHandler) AddSubCommands(subcommands ...ports.Command) {
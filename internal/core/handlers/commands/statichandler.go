@@ -2,6 +2,8 @@
 package cmdhandlers
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 
 	"github.com/bartosian/suimon/internal/core/ports"
@@ -24,8 +26,8 @@ func NewStaticHandler(
 	return handler
 }
 
-func (h *StaticHandler) Start() {
-	_ = h.command.Execute()
+func (h *StaticHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
 }
 
 func (h *StaticHandler) AddSubCommands(subcommands ...ports.Command) {
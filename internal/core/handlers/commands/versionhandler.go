@@ -2,6 +2,8 @@
 package cmdhandlers
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 
 	"github.com/bartosian/suimon/internal/core/ports"
@@ -24,8 +26,8 @@ func NewVersionHandler(
 	return handler
 }
 
-func (h *VersionHandler) Start() {
-	_ = h.command.Execute()
+func (h *VersionHandler) Start(ctx context.Context) {
+	_ = h.command.ExecuteContext(ctx)
 }
 
 func (h *VersionHandler) AddSubCommands(subcommands ...ports.Command) {
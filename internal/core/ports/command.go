@@ -1,9 +1,13 @@
 package ports
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
 
 type Command interface {
-	Start()
+	Start(ctx context.Context)
 	AddSubCommands(subcommands ...Command)
 	Command() *cobra.Command
 }
@@ -1,5 +1,13 @@
 package ports
 
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
 type RootController interface {
 	BeforeStart() bool
 }
@@ -8,8 +16,65 @@ type VersionController interface {
 	PrintVersion()
 }
 
+type CacheController interface {
+	Clear() error
+}
+
 type MonitorController interface {
-	Monitor() error
-	Static() error
-	Dynamic() error
+	Monitor(ctx context.Context, options MonitorOptions) error
+	Static(ctx context.Context) error
+	Dynamic(ctx context.Context) error
+}
+
+// MonitorOptions represents the options of the monitor set from the command line.
+// ProtocolFilter, if set, limits the feature flags and attributes shown in the Protocol table to the ones with a matching name.
+type MonitorOptions struct {
+	ProtocolFilter *regexp.Regexp
+}
+
+type ProtocolController interface {
+	DiffProtocol(ctx context.Context, query ProtocolDiffQuery) error
+}
+
+// ProtocolDiffQuery selects the protocol versions to compare. Empty From and To default to the current protocol version
+// and the maximum protocol version supported by the network. Filter, if set, limits the entries compared to the ones with a matching name.
+type ProtocolDiffQuery struct {
+	From   string
+	To     string
+	Filter *regexp.Regexp
+}
+
+type ReleasesController interface {
+	ShowRelease(ctx context.Context, tag string) error
+	ShowReleaseChanges(ctx context.Context) error
+}
+
+type ForecastController interface {
+	ForecastSubsidy(ctx context.Context, query SubsidyForecastQuery) error
+}
+
+// SubsidyForecastQuery sets the number of the stake subsidy periods to forecast, the stake in SUI to estimate
+// the staking rewards for and the width of the chart of the distributions in characters.
+type SubsidyForecastQuery struct {
+	Periods int
+	Stake   float64
+	Width   int
+}
+
+type ReportsController interface {
+	WatchEpochs(ctx context.Context, interval time.Duration) error
+}
+
+type HistoryController interface {
+	Show(query HistoryQuery) error
+}
+
+// HistoryQuery selects the hosts and the metric to show from the metrics history.
+// Empty Network and Host match all the networks and hosts, Host matches any address containing it.
+type HistoryQuery struct {
+	Network string
+	Host    string
+	Metric  enums.MetricType
+	Since   time.Duration
+	Width   int
 }
@@ -1,35 +1,92 @@
 package ports
 
 import (
+	"context"
+	"errors"
 	"net"
+	"regexp"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/pkg/histogram"
+	"github.com/bartosian/suimon/internal/pkg/latency"
 )
 
 type RPCGateway interface {
-	CallFor(method enums.RPCMethod, params ...interface{}) (result any, err error)
+	CallFor(ctx context.Context, method enums.RPCMethod, params ...interface{}) (result any, err error)
+	Latency() latency.Stats
 }
 
 type PrometheusGateway interface {
-	CallFor(metrics Metrics) (result MetricsResult, err error)
+	CallFor(ctx context.Context, metrics Metrics) (result MetricsResult, err error)
+	Latency() latency.Stats
 }
 
 type GeoGateway interface {
-	CallFor(ip net.IP) (result *IPResult, err error)
+	CallFor(ctx context.Context, ip net.IP) (result *IPResult, err error)
 }
 
+type HistoryGateway interface {
+	Record(records []HistoryRecord) error
+	Keys() ([]HistoryKey, error)
+	Samples(key HistoryKey, since time.Time) ([]HistorySample, error)
+}
+
+type ReportGateway interface {
+	SaveEpochReport(report *metrics.EpochReport) (paths []string, err error)
+}
+
+type SnapshotGateway interface {
+	ValidatorSetSnapshots(network string) ([]metrics.ValidatorSetSnapshot, error)
+	SaveValidatorSetSnapshot(snapshot metrics.ValidatorSetSnapshot) error
+}
+
+type ReleaseGateway interface {
+	Releases(ctx context.Context, query ReleaseQuery) ([]metrics.Release, error)
+	Release(ctx context.Context, tag string) (*metrics.Release, error)
+}
+
+// ReleaseQuery selects the releases to fetch. Pattern, if set, is matched against the name and the tag name of the releases,
+// and at most Count releases matching are returned, all of them if Count is zero.
+type ReleaseQuery struct {
+	Pattern *regexp.Regexp
+	Count   int
+}
+
+// ErrMetricNotFound is set on the result of a metric that is not exposed under any of its names.
+var ErrMetricNotFound = errors.New("metric not found")
+
+// MetricResult represents the value of a single metric. Err is set if the metric could not be collected,
+// in which case the other fields are empty. Name is the name the metric was found under.
 type MetricResult struct {
-	Labels prometheus.Labels
-	Value  float64
+	Err       error
+	Labels    prometheus.Labels
+	Histogram *histogram.Snapshot
+	Rate      *float64
+	Name      enums.PrometheusMetricName
+	Value     float64
 }
 
 type MetricsResult map[enums.PrometheusMetricName]MetricResult
 
+// MetricConfig represents the metric requested. Aliases lists the names the metric is looked up by in order of preference,
+// e.g. the names used by different sui-node releases; the name the metric is requested with is used if no aliases are set.
 type MetricConfig struct {
 	Labels     prometheus.Labels
 	MetricType enums.PrometheusMetricType
+	Aliases    []enums.PrometheusMetricName
+}
+
+// Names returns the names the metric with the given name is looked up by in order of preference.
+func (config MetricConfig) Names(name enums.PrometheusMetricName) []enums.PrometheusMetricName {
+	if len(config.Aliases) > 0 {
+		return config.Aliases
+	}
+
+	return []enums.PrometheusMetricName{name}
 }
 
 type Metrics map[enums.PrometheusMetricName]MetricConfig
@@ -49,5 +106,26 @@ type IPResult struct {
 	CountryName  string
 	CountryEmoji string
 	Location     string
+	ASN          string
 	IP           net.IP
 }
+
+// HistoryKey identifies the series of samples recorded for a host of a table in a network.
+type HistoryKey struct {
+	Network string          `json:"network"`
+	Table   enums.TableType `json:"table"`
+	Address string          `json:"address"`
+}
+
+// HistorySample represents the status and the key metrics of a host at a point in time.
+type HistorySample struct {
+	Time   time.Time                    `json:"time"`
+	Status enums.Status                 `json:"status"`
+	Values map[enums.MetricType]float64 `json:"values"`
+}
+
+// HistoryRecord represents a sample to be recorded for the host identified by the key.
+type HistoryRecord struct {
+	Key    HistoryKey
+	Sample HistorySample
+}
@@ -29,6 +29,11 @@ const (
 	peerParts                    = 5
 )
 
+var (
+	peerHostProtocols      = map[string]struct{}{"ip4": {}, "ip6": {}, "dns": {}, "dns4": {}, "dns6": {}}
+	peerTransportProtocols = map[string]struct{}{"udp": {}, "tcp": {}}
+)
+
 // GetHostWithPath returns the host with the path, if available.
 // If the host is nil, it returns nil. Otherwise, it concatenates the host and path and returns the result.
 // Returns a pointer to the concatenated host with the path.
@@ -73,20 +78,21 @@ func ParseIPPort(address string) (*Endpoint, error) {
 	}, nil
 }
 
-// ParsePeer parses the given address and returns an Endpoint and an error.
-// If the address is in the format "/ip4/host/udp/port", it returns the host and port as an Endpoint.
+// ParsePeer parses the given multiaddr and returns an Endpoint and an error.
+// The address is expected in the format "/<ip4|ip6|dns|dns4|dns6>/host/<udp|tcp>/port", optionally followed by
+// further components such as "/http", which are ignored. For the IP formats the IP is set in the Endpoint, otherwise the host.
 // If the IP provided is a loopback or unspecified IP, it replaces it with the public IP.
 // Returns the parsed Endpoint and nil error if successful, otherwise returns nil and an error.
 func ParsePeer(address string) (*Endpoint, error) {
 	components := strings.Split(address, "/")
 
-	if len(components) != peerParts {
+	if len(components) < peerParts {
 		return nil, fmt.Errorf(errInvalidPeerFormatProvided, address)
 	}
 
-	validProtocol := components[3] == "udp"
 	validFirstComponent := components[0] == ""
-	validSecondComponent := components[1] == "ip4" || components[1] == "dns"
+	_, validSecondComponent := peerHostProtocols[components[1]]
+	_, validProtocol := peerTransportProtocols[components[3]]
 
 	if !validProtocol || !validFirstComponent || !validSecondComponent {
 		return nil, fmt.Errorf(errInvalidPeerFormatProvided, address)
@@ -0,0 +1,63 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ErrDNSResolution is returned when the host of an endpoint can not be resolved to IP addresses.
+var ErrDNSResolution = errors.New("dns resolution failed")
+
+// ResolveIPs returns the IP addresses of the endpoint.
+// Endpoints with an IP address are resolved to that address, while hostnames are looked up in DNS and all A and AAAA records are returned.
+// It returns an error wrapping ErrDNSResolution if the lookup fails or returns no records.
+func (hp *Endpoint) ResolveIPs(ctx context.Context) ([]net.IP, error) {
+	if hp.IP != nil {
+		ip := net.ParseIP(*hp.IP)
+		if ip == nil {
+			return nil, fmt.Errorf(errInvalidIPProvided, *hp.IP)
+		}
+
+		return []net.IP{ip}, nil
+	}
+
+	if hp.Host == nil {
+		return nil, nil
+	}
+
+	hostname := *hp.Host
+
+	if ip := net.ParseIP(hostname); ip != nil {
+		return []net.IP{ip}, nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s: %v", ErrDNSResolution, hostname, err)
+	}
+
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("%w for %s: no A or AAAA records found", ErrDNSResolution, hostname)
+	}
+
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		ips = append(ips, addr.IP)
+	}
+
+	return ips, nil
+}
+
+// FirstPublicIP returns the first public IP address in the list.
+// It returns nil if all the addresses are private, loopback or link-local.
+func FirstPublicIP(ips []net.IP) net.IP {
+	for _, ip := range ips {
+		if ip.IsGlobalUnicast() && !ip.IsPrivate() {
+			return ip
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package asciichart
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	labelSeparator = " "
+	labelDecimals  = 2
+)
+
+// Plot renders the series as a line chart drawn with box-drawing characters, with the values on the vertical axis.
+// The chart is height rows high, and the series is downsampled to width points by averaging if it is longer.
+// It returns an empty string if the series is empty.
+func Plot(series []float64, height, width int) string {
+	if len(series) == 0 || height < 1 || width < 1 {
+		return ""
+	}
+
+	series = resample(series, width)
+
+	minValue, maxValue := series[0], series[0]
+	for _, value := range series {
+		minValue = math.Min(minValue, value)
+		maxValue = math.Max(maxValue, value)
+	}
+
+	rows := height - 1
+	valueRange := maxValue - minValue
+
+	// A constant series is drawn as a single row.
+	if valueRange == 0 {
+		rows, valueRange = 0, 1
+	}
+
+	// toRow returns the row of the value, counted from the bottom of the chart.
+	toRow := func(value float64) int {
+		return int(math.Round((value - minValue) / valueRange * float64(rows)))
+	}
+
+	labels := make([]string, rows+1)
+	labelWidth := 0
+
+	for row := range labels {
+		labels[row] = strconv.FormatFloat(minValue+valueRange*float64(row)/math.Max(float64(rows), 1), 'f', labelDecimals, 64)
+		labelWidth = max(labelWidth, len(labels[row]))
+	}
+
+	grid := make([][]rune, rows+1)
+	for row := range grid {
+		grid[row] = []rune(strings.Repeat(" ", len(series)))
+	}
+
+	// set sets the cell of the grid for the row counted from the bottom of the chart.
+	set := func(row, column int, char rune) {
+		grid[rows-row][column] = char
+	}
+
+	set(toRow(series[0]), 0, '─')
+
+	for column := 1; column < len(series); column++ {
+		previous, current := toRow(series[column-1]), toRow(series[column])
+
+		switch {
+		case previous == current:
+			set(current, column, '─')
+		case previous < current:
+			set(previous, column, '╯')
+			set(current, column, '╭')
+
+			for row := previous + 1; row < current; row++ {
+				set(row, column, '│')
+			}
+		default:
+			set(previous, column, '╮')
+			set(current, column, '╰')
+
+			for row := current + 1; row < previous; row++ {
+				set(row, column, '│')
+			}
+		}
+	}
+
+	lines := make([]string, 0, rows+1)
+
+	for row := rows; row >= 0; row-- {
+		label := strings.Repeat(" ", labelWidth-len(labels[row])) + labels[row]
+
+		lines = append(lines, label+labelSeparator+"┤"+string(grid[rows-row]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// resample downsamples the series to the width by averaging the consecutive values falling into the same column.
+func resample(series []float64, width int) []float64 {
+	if len(series) <= width {
+		return series
+	}
+
+	resampled := make([]float64, width)
+
+	for column := range resampled {
+		start := column * len(series) / width
+		end := (column + 1) * len(series) / width
+
+		var sum float64
+		for _, value := range series[start:end] {
+			sum += value
+		}
+
+		resampled[column] = sum / float64(end-start)
+	}
+
+	return resampled
+}
@@ -0,0 +1,125 @@
+package diskcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+	fileExtension   = ".json"
+)
+
+// Cache stores JSON encoded values on disk, one file per key, and expires them after the TTL.
+// The files are written atomically, so the cache can be shared by concurrent processes.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+// entry represents a value stored in the cache together with the time it was stored at.
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// New creates a cache storing the values in the given directory, creating the directory if needed.
+func New(dir string, ttl time.Duration) (*Cache, error) {
+	if err := os.MkdirAll(dir, dirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", dir, err)
+	}
+
+	return &Cache{dir: dir, ttl: ttl}, nil
+}
+
+// Get decodes the value stored for the key into the value provided.
+// It reports whether a value that has not expired yet was found.
+func (c *Cache) Get(key string, value any) (bool, error) {
+	data, err := os.ReadFile(c.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to read cache entry %s: %w", key, err)
+	}
+
+	var stored entry
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return false, fmt.Errorf("failed to decode cache entry %s: %w", key, err)
+	}
+
+	if time.Since(stored.StoredAt) > c.ttl {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(stored.Value, value); err != nil {
+		return false, fmt.Errorf("failed to decode cache entry %s: %w", key, err)
+	}
+
+	return true, nil
+}
+
+// Set stores the value for the key, replacing the previous one.
+func (c *Cache) Set(key string, value any) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry %s: %w", key, err)
+	}
+
+	data, err := json.Marshal(entry{StoredAt: time.Now(), Value: encoded})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry %s: %w", key, err)
+	}
+
+	file, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+
+	if err := os.Chmod(file.Name(), filePermissions); err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+
+	if err := os.Rename(file.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// path returns the path of the file the value for the key is stored in.
+// The key is hashed, so any string can be used as a key.
+func (c *Cache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(hash[:])+fileExtension)
+}
+
+// Clear removes the cache directory with all the values stored in it.
+func Clear(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove cache directory %s: %w", dir, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package histogram
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	Quantile50 = 0.5
+	Quantile95 = 0.95
+	Quantile99 = 0.99
+
+	millisecondsInSecond = 1000
+	precisionThreshold   = 10
+)
+
+type (
+	// Bucket represents a cumulative histogram bucket.
+	Bucket struct {
+		UpperBound      float64
+		CumulativeCount float64
+	}
+
+	// Snapshot represents the state of a histogram or a summary at the time of a scrape.
+	// Histograms provide buckets, summaries provide precomputed quantiles.
+	Snapshot struct {
+		Quantiles map[float64]float64
+		Buckets   []Bucket
+		Sum       float64
+		Count     float64
+	}
+
+	// Stats represents the quantiles and the average of the observations made between two scrapes.
+	// The values are in the units of the observed metric.
+	Stats struct {
+		P50   float64
+		P95   float64
+		P99   float64
+		Avg   float64
+		Count float64
+	}
+
+	// Window keeps the previous snapshot of a histogram to compute statistics for the observations made between scrapes.
+	Window struct {
+		previous *Snapshot
+		stats    Stats
+	}
+)
+
+// Quantile estimates the q-quantile of the observations in the snapshot.
+// Precomputed summary quantiles are returned as is. For histograms the value is linearly interpolated
+// within the bucket the quantile falls into, following the PromQL histogram_quantile function.
+// It returns NaN if there are no observations.
+func (s Snapshot) Quantile(q float64) float64 {
+	if value, ok := s.Quantiles[q]; ok {
+		return value
+	}
+
+	buckets := s.sortedBuckets()
+	if len(buckets) == 0 {
+		return math.NaN()
+	}
+
+	total := buckets[len(buckets)-1].CumulativeCount
+	if total == 0 {
+		return math.NaN()
+	}
+
+	rank := q * total
+
+	idx := sort.Search(len(buckets), func(i int) bool {
+		return buckets[i].CumulativeCount >= rank
+	})
+
+	// The quantile falls into the +Inf bucket, so the upper bound of the highest finite bucket is returned.
+	if idx == len(buckets)-1 && math.IsInf(buckets[idx].UpperBound, 1) {
+		if idx == 0 {
+			return math.NaN()
+		}
+
+		return buckets[idx-1].UpperBound
+	}
+
+	var (
+		lowerBound float64
+		lowerCount float64
+		upperBound = buckets[idx].UpperBound
+	)
+
+	if idx > 0 {
+		lowerBound = buckets[idx-1].UpperBound
+		lowerCount = buckets[idx-1].CumulativeCount
+	} else if upperBound <= 0 {
+		return upperBound
+	}
+
+	bucketCount := buckets[idx].CumulativeCount - lowerCount
+	if bucketCount == 0 {
+		return upperBound
+	}
+
+	return lowerBound + (upperBound-lowerBound)*(rank-lowerCount)/bucketCount
+}
+
+// Delta returns the observations made since the previous snapshot.
+// It returns false if the histogram was reset in between or the bucket layout has changed.
+func (s Snapshot) Delta(previous Snapshot) (Snapshot, bool) {
+	if s.Count < previous.Count || s.Sum < previous.Sum || len(s.Buckets) != len(previous.Buckets) {
+		return Snapshot{}, false
+	}
+
+	current, prev := s.sortedBuckets(), previous.sortedBuckets()
+
+	delta := Snapshot{
+		Quantiles: s.Quantiles,
+		Buckets:   make([]Bucket, len(current)),
+		Sum:       s.Sum - previous.Sum,
+		Count:     s.Count - previous.Count,
+	}
+
+	for idx := range current {
+		if current[idx].UpperBound != prev[idx].UpperBound || current[idx].CumulativeCount < prev[idx].CumulativeCount {
+			return Snapshot{}, false
+		}
+
+		delta.Buckets[idx] = Bucket{
+			UpperBound:      current[idx].UpperBound,
+			CumulativeCount: current[idx].CumulativeCount - prev[idx].CumulativeCount,
+		}
+	}
+
+	return delta, true
+}
+
+// Stats computes the p50, p95 and p99 quantiles and the average of the observations in the snapshot.
+func (s Snapshot) Stats() Stats {
+	stats := Stats{
+		P50:   s.Quantile(Quantile50),
+		P95:   s.Quantile(Quantile95),
+		P99:   s.Quantile(Quantile99),
+		Count: s.Count,
+	}
+
+	if s.Count > 0 {
+		stats.Avg = s.Sum / s.Count
+	}
+
+	return stats
+}
+
+// sortedBuckets returns the buckets sorted by their upper bounds.
+func (s Snapshot) sortedBuckets() []Bucket {
+	buckets := make([]Bucket, len(s.Buckets))
+	copy(buckets, s.Buckets)
+
+	sort.Slice(buckets, func(left, right int) bool {
+		return buckets[left].UpperBound < buckets[right].UpperBound
+	})
+
+	return buckets
+}
+
+// Update records the new snapshot and returns the statistics for the observations made since the previous one,
+// which is the equivalent of histogram_quantile over rate() and rate(sum)/rate(count) in PromQL.
+// The cumulative statistics are used for the first snapshot and after a reset.
+// If nothing was observed since the previous snapshot, the last statistics are kept.
+func (w *Window) Update(current Snapshot) Stats {
+	defer func() {
+		w.previous = &current
+	}()
+
+	if w.previous == nil {
+		w.stats = current.Stats()
+
+		return w.stats
+	}
+
+	delta, ok := current.Delta(*w.previous)
+	if !ok {
+		w.stats = current.Stats()
+
+		return w.stats
+	}
+
+	if delta.Count == 0 {
+		return w.stats
+	}
+
+	w.stats = delta.Stats()
+
+	return w.stats
+}
+
+// Stats returns the statistics computed on the last update.
+func (w *Window) Stats() Stats {
+	return w.stats
+}
+
+// IsEmpty reports whether no observations were made.
+func (s Stats) IsEmpty() bool {
+	return s.Count == 0
+}
+
+// Milliseconds returns the statistics formatted as "p50 / p95 / p99 / avg" in milliseconds.
+// The observations are expected to be in seconds, as the latency histograms exposed by sui-node are.
+func (s Stats) Milliseconds() string {
+	return s.Scaled(millisecondsInSecond)
+}
+
+// Scaled returns the statistics multiplied by the scale and formatted as "p50 / p95 / p99 / avg".
+// Small values keep one decimal.
+func (s Stats) Scaled(scale float64) string {
+	if s.IsEmpty() {
+		return ""
+	}
+
+	return fmt.Sprintf("%s / %s / %s / %s",
+		formatScaled(s.P50, scale), formatScaled(s.P95, scale), formatScaled(s.P99, scale), formatScaled(s.Avg, scale))
+}
+
+// formatScaled multiplies the value by the scale, keeping one decimal for small values.
+func formatScaled(value, scale float64) string {
+	if math.IsNaN(value) {
+		return "-"
+	}
+
+	scaled := value * scale
+	if scaled < precisionThreshold {
+		return fmt.Sprintf("%.1f", scaled)
+	}
+
+	return fmt.Sprintf("%.0f", scaled)
+}
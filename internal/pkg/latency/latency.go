@@ -0,0 +1,115 @@
+package latency
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWindowSize = 100
+	percentile95      = 0.95
+)
+
+// Stats represents the round-trip time statistics collected for a single endpoint.
+type Stats struct {
+	Last    time.Duration
+	Avg     time.Duration
+	P95     time.Duration
+	Samples int
+}
+
+// Tracker records round-trip times for calls made to a single endpoint.
+// It keeps a sliding window of the most recent samples and is safe for concurrent use.
+type Tracker struct {
+	samples []time.Duration
+	size    int
+	lock    sync.RWMutex
+}
+
+// NewTracker creates a new Tracker that keeps the default number of most recent samples.
+func NewTracker() *Tracker {
+	return &Tracker{
+		size:    defaultWindowSize,
+		samples: make([]time.Duration, 0, defaultWindowSize),
+	}
+}
+
+// Record adds a new round-trip time sample, evicting the oldest one when the window is full.
+func (t *Tracker) Record(rtt time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.samples) == t.size {
+		t.samples = t.samples[1:]
+	}
+
+	t.samples = append(t.samples, rtt)
+}
+
+// Since records the time elapsed since the provided start time.
+// It is meant to be deferred right before making a call.
+func (t *Tracker) Since(start time.Time) {
+	t.Record(time.Since(start))
+}
+
+// Stats returns the last, average and 95th percentile round-trip times for the recorded samples.
+// If no samples have been recorded yet, it returns zero values.
+func (t *Tracker) Stats() Stats {
+	if t == nil {
+		return Stats{}
+	}
+
+	t.lock.RLock()
+	samples := make([]time.Duration, len(t.samples))
+	copy(samples, t.samples)
+	t.lock.RUnlock()
+
+	if len(samples) == 0 {
+		return Stats{}
+	}
+
+	stats := Stats{
+		Last:    samples[len(samples)-1],
+		Samples: len(samples),
+	}
+
+	var total time.Duration
+	for _, sample := range samples {
+		total += sample
+	}
+
+	stats.Avg = total / time.Duration(len(samples))
+
+	sort.Slice(samples, func(left, right int) bool {
+		return samples[left] < samples[right]
+	})
+
+	idx := int(float64(len(samples))*percentile95+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+
+	if idx >= len(samples) {
+		idx = len(samples) - 1
+	}
+
+	stats.P95 = samples[idx]
+
+	return stats
+}
+
+// IsEmpty reports whether no samples have been recorded.
+func (s Stats) IsEmpty() bool {
+	return s.Samples == 0
+}
+
+// String returns the statistics formatted as "last / avg / p95 ms".
+func (s Stats) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d / %d / %d ms", s.Last.Milliseconds(), s.Avg.Milliseconds(), s.P95.Milliseconds())
+}
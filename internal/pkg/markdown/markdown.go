@@ -0,0 +1,94 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+var (
+	headingRegexp  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletRegexp   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	linkRegexp     = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	emphasisRegexp = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	codeRegexp     = regexp.MustCompile("`([^`]+)`")
+
+	headingColor   = color.New(color.FgCyan, color.Bold)
+	emphasisColor  = color.New(color.Bold)
+	codeColor      = color.New(color.FgGreen)
+	linkColor      = color.New(color.FgBlue, color.Underline)
+	highlightColor = color.New(color.FgBlack, color.BgYellow)
+)
+
+// Render renders the markdown text for the terminal: the headings are colored, the bullets are replaced with dots,
+// the emphasis and the inline code are styled and the links are shown with their URLs.
+// The lines matching the highlight expression, if set, are highlighted. Code blocks are kept as they are.
+func Render(text string, highlight *regexp.Regexp) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	rendered := make([]string, 0, len(lines))
+
+	var inCodeBlock bool
+
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+
+			continue
+		}
+
+		if inCodeBlock {
+			rendered = append(rendered, codeColor.Sprint("    "+line))
+
+			continue
+		}
+
+		rendered = append(rendered, renderLine(line, highlight != nil && highlight.MatchString(line)))
+	}
+
+	return strings.Join(rendered, "\n")
+}
+
+// renderLine renders a single line outside the code blocks, highlighting it as a whole if requested.
+func renderLine(line string, highlighted bool) string {
+	if match := headingRegexp.FindStringSubmatch(line); match != nil {
+		return headingColor.Sprint(strings.ToUpper(Plain(match[2])))
+	}
+
+	var prefix string
+
+	if match := bulletRegexp.FindStringSubmatch(line); match != nil {
+		prefix, line = match[1]+"• ", match[2]
+	}
+
+	if highlighted {
+		return prefix + highlightColor.Sprint(Plain(line))
+	}
+
+	return prefix + renderInline(line)
+}
+
+// renderInline styles the emphasis, the inline code and the links of the line.
+func renderInline(line string) string {
+	line = emphasisRegexp.ReplaceAllStringFunc(line, func(match string) string {
+		return emphasisColor.Sprint(match[2 : len(match)-2])
+	})
+
+	line = codeRegexp.ReplaceAllStringFunc(line, func(match string) string {
+		return codeColor.Sprint(match[1 : len(match)-1])
+	})
+
+	return linkRegexp.ReplaceAllStringFunc(line, func(match string) string {
+		parts := linkRegexp.FindStringSubmatch(match)
+
+		return parts[1] + " (" + linkColor.Sprint(parts[2]) + ")"
+	})
+}
+
+// Plain removes the inline markdown markup of the line without styling it, so it can be colored as a whole.
+func Plain(line string) string {
+	line = emphasisRegexp.ReplaceAllString(line, "$1$2")
+	line = codeRegexp.ReplaceAllString(line, "$1")
+
+	return linkRegexp.ReplaceAllString(line, "$1 ($2)")
+}
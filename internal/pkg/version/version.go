@@ -0,0 +1,73 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var versionPattern = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+
+// Version represents a semantic version of a sui-node release.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Parse extracts the version from a string such as "1.30.1", "v1.30.1", "mainnet-v1.30.1" or "1.30.1-a5c7f9b".
+// It returns an error if the string contains no version.
+func Parse(value string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(value)
+	if match == nil {
+		return Version{}, fmt.Errorf("invalid version: %q", value)
+	}
+
+	var (
+		parts [3]int
+		err   error
+	)
+
+	for idx := range parts {
+		if parts[idx], err = strconv.Atoi(match[idx+1]); err != nil {
+			return Version{}, fmt.Errorf("invalid version: %q: %w", value, err)
+		}
+	}
+
+	return Version{Major: parts[0], Minor: parts[1], Patch: parts[2]}, nil
+}
+
+// MustParse is like Parse but panics if the string contains no version.
+// It is intended for the versions hardcoded in the source.
+func MustParse(value string) Version {
+	parsed, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// Compare returns -1 if the version is lower than the other one, 1 if it is higher and 0 if they are equal.
+func (v Version) Compare(other Version) int {
+	for _, diff := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		switch {
+		case diff < 0:
+			return -1
+		case diff > 0:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// IsZero reports whether the version is unset.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// String returns the version in the "major.minor.patch" form.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}